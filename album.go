@@ -3,6 +3,7 @@ package jiosaavn
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 // Album.
@@ -16,6 +17,7 @@ type Album struct {
 	Year            int
 	PlayCount       int
 	SongCount       int
+	ReleaseDate     time.Time
 	PrimaryArtists  []Artist
 	FeaturedArtists []Artist
 }
@@ -43,11 +45,12 @@ type getAlbumAPIResponse struct {
 	ListType        string   `json:"list_type"`
 	List            songList `json:"list"`
 	MoreInfo        struct {
-		Query     string `json:"query"`
-		Text      string `json:"text"`
-		Music     string `json:"music"`
-		SongCount string `json:"song_count"`
-		ArtistMap struct {
+		Query       string `json:"query"`
+		Text        string `json:"text"`
+		Music       string `json:"music"`
+		SongCount   string `json:"song_count"`
+		ReleaseDate string `json:"release_date"`
+		ArtistMap   struct {
 			PrimaryArtists  []artistAPIResponse `json:"primary_artists"`
 			FeaturedArtists []artistAPIResponse `json:"featured_artists"`
 			Artists         []artistAPIResponse `json:"artists"`
@@ -55,10 +58,15 @@ type getAlbumAPIResponse struct {
 	} `json:"more_info"`
 }
 
+func (res *getAlbumAPIResponse) count() int {
+	return len(res.List)
+}
+
 func (res *getAlbumAPIResponse) toAlbum() Album {
 	year, _ := strconv.Atoi(res.Year)
 	playCount, _ := strconv.Atoi(res.PlayCount)
 	songCount, _ := strconv.Atoi(res.MoreInfo.SongCount)
+	releaseDate, _ := time.Parse(time.DateOnly, res.MoreInfo.ReleaseDate)
 	album := Album{
 		ID:           res.ID,
 		Title:        res.Title,
@@ -69,6 +77,7 @@ func (res *getAlbumAPIResponse) toAlbum() Album {
 		Year:         year,
 		PlayCount:    playCount,
 		SongCount:    songCount,
+		ReleaseDate:  releaseDate,
 	}
 
 	primaryArtists := make([]Artist, 0)
@@ -86,9 +95,18 @@ func (res *getAlbumAPIResponse) toAlbum() Album {
 	return album
 }
 
-func (res *getAlbumAPIResponse) toAlbumInfo() (AlbumInfo, error) {
+func (res *getAlbumAPIResponse) validate() error {
 	if len(res.Title) == 0 || len(res.List) == 0 {
-		return AlbumInfo{}, fmt.Errorf("invalid album id")
+		return fmt.Errorf("invalid album id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getAlbumAPIResponse) toAlbumInfo() (AlbumInfo, error) {
+	err := res.validate()
+	if err != nil {
+		return AlbumInfo{}, err
 	}
 
 	album := res.toAlbum()
@@ -1,5 +1,13 @@
 package jiosaavn
 
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
 // Artist.
 type Artist struct {
 	ID           string
@@ -7,3 +15,152 @@ type Artist struct {
 	Image        string
 	PermanentURL string
 }
+
+// Artist Info.
+type ArtistInfo struct {
+	Artist
+	FollowerCount      int
+	FanCount           int
+	Verified           bool
+	Bio                string
+	DominantLanguage   string
+	DominantType       string
+	AvailableLanguages []string
+	TopSongs           []Song
+	TopAlbums          []Album
+	Singles            []Album
+	SimilarArtists     []Artist
+	FeaturedIn         []Playlist
+}
+
+// Get Artist API Response.
+type getArtistAPIResponse struct {
+	ArtistID               string                  `json:"artistId"`
+	Name                   string                  `json:"name"`
+	Subtitle               string                  `json:"subtitle"`
+	Image                  string                  `json:"image"`
+	PermaURL               string                  `json:"perma_url"`
+	FollowerCount          string                  `json:"follower_count"`
+	FanCount               string                  `json:"fan_count"`
+	Type                   string                  `json:"type"`
+	IsVerified             bool                    `json:"isVerified"`
+	DominantLanguage       string                  `json:"dominantLanguage"`
+	DominantType           string                  `json:"dominantType"`
+	Bio                    string                  `json:"bio"`
+	AvailableLanguages     []string                `json:"availableLanguages"`
+	IsRadioPresent         bool                    `json:"isRadioPresent"`
+	URLs                   map[string]string       `json:"urls"`
+	TopSongs               []songAPIResponse       `json:"topSongs"`
+	TopAlbums              []getAlbumAPIResponse   `json:"topAlbums"`
+	Singles                []getAlbumAPIResponse   `json:"singles"`
+	FeaturedArtistPlaylist []playlistAPIResponse   `json:"featured_artist_playlist"`
+	SimilarArtists         []similarArtistResponse `json:"similarArtists"`
+}
+
+// Similar Artist API Response.
+type similarArtistResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	ImageURL string `json:"image_url"`
+	PermaURL string `json:"perma_url"`
+	Type     string `json:"type"`
+}
+
+// Artist Bio API Response, the bio is a json encoded list of sections.
+type artistBioAPIResponse struct {
+	Title    string `json:"title"`
+	Text     string `json:"text"`
+	Sequence int    `json:"sequence"`
+}
+
+func (res *getArtistAPIResponse) validate() error {
+	if len(res.ArtistID) == 0 && len(res.Name) == 0 {
+		return fmt.Errorf("invalid artist id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getArtistAPIResponse) toArtistInfo() (ArtistInfo, error) {
+	err := res.validate()
+	if err != nil {
+		return ArtistInfo{}, err
+	}
+
+	if len(res.PermaURL) == 0 {
+		res.PermaURL = res.URLs["overview"]
+	}
+
+	followerCount, _ := strconv.Atoi(res.FollowerCount)
+	fanCount, _ := strconv.Atoi(res.FanCount)
+	info := ArtistInfo{
+		Artist: Artist{
+			ID:           res.ArtistID,
+			Name:         html.UnescapeString(res.Name),
+			Image:        res.Image,
+			PermanentURL: res.PermaURL,
+		},
+		FollowerCount:      followerCount,
+		FanCount:           fanCount,
+		Verified:           res.IsVerified,
+		Bio:                parseArtistBio(res.Bio),
+		DominantLanguage:   res.DominantLanguage,
+		DominantType:       res.DominantType,
+		AvailableLanguages: res.AvailableLanguages,
+	}
+
+	songs := make([]Song, 0)
+	for _, s := range res.TopSongs {
+		songs = append(songs, s.toSong())
+	}
+	info.TopSongs = songs
+
+	albums := make([]Album, 0)
+	for _, a := range res.TopAlbums {
+		albums = append(albums, a.toAlbum())
+	}
+	info.TopAlbums = albums
+
+	singles := make([]Album, 0)
+	for _, a := range res.Singles {
+		singles = append(singles, a.toAlbum())
+	}
+	info.Singles = singles
+
+	similarArtists := make([]Artist, 0)
+	for _, a := range res.SimilarArtists {
+		similarArtists = append(similarArtists, Artist{
+			ID:           a.ID,
+			Name:         html.UnescapeString(a.Name),
+			Image:        a.ImageURL,
+			PermanentURL: a.PermaURL,
+		})
+	}
+	info.SimilarArtists = similarArtists
+
+	playlists := make([]Playlist, 0)
+	for _, p := range res.FeaturedArtistPlaylist {
+		playlists = append(playlists, p.toPlaylist())
+	}
+	info.FeaturedIn = playlists
+
+	return info, nil
+}
+
+// parseArtistBio joins the bio sections, the bio can also be plain text
+func parseArtistBio(bio string) string {
+	var sections []artistBioAPIResponse
+	if err := json.Unmarshal([]byte(bio), &sections); err != nil {
+		return html.UnescapeString(strings.TrimSpace(bio))
+	}
+
+	texts := make([]string, 0, len(sections))
+	for _, section := range sections {
+		text := strings.TrimSpace(html.UnescapeString(section.Text))
+		if len(text) > 0 {
+			texts = append(texts, text)
+		}
+	}
+
+	return strings.Join(texts, "\n\n")
+}
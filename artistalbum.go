@@ -0,0 +1,76 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+)
+
+// Artist albums results.
+type ArtistAlbumsResults struct {
+	Page    int
+	Size    int
+	Total   int
+	HasNext bool
+	Albums  []Album
+
+	// for next
+	c             *Client
+	artistID      string
+	searchOptions *searchOptions
+}
+
+// Artist albums API response.
+type artistAlbumsAPIResponse struct {
+	ArtistID  string `json:"artistId"`
+	Name      string `json:"name"`
+	TopAlbums struct {
+		Albums   []getAlbumAPIResponse `json:"albums"`
+		Total    int                   `json:"total"`
+		LastPage bool                  `json:"last_page"`
+	} `json:"topAlbums"`
+}
+
+func (resp *artistAlbumsAPIResponse) count() int {
+	return len(resp.TopAlbums.Albums)
+}
+
+func (resp *artistAlbumsAPIResponse) toResults(c *Client, artistID string, opts *searchOptions) (ArtistAlbumsResults, error) {
+	albums := make([]Album, 0)
+
+	for _, result := range resp.TopAlbums.Albums {
+		albums = append(albums, result.toAlbum())
+	}
+
+	offset := (opts.page - 1) * opts.limit
+	hasNext := len(albums) > 0 && !resp.TopAlbums.LastPage && offset+len(albums) < resp.TopAlbums.Total
+	if !hasNext {
+		return ArtistAlbumsResults{
+			Page:    opts.page,
+			Size:    len(albums),
+			Total:   resp.TopAlbums.Total,
+			HasNext: hasNext,
+			Albums:  albums,
+		}, nil
+	}
+
+	return ArtistAlbumsResults{
+		Page:          opts.page,
+		Size:          len(albums),
+		Total:         resp.TopAlbums.Total,
+		HasNext:       hasNext,
+		Albums:        albums,
+		c:             c,
+		artistID:      artistID,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *ArtistAlbumsResults) Next(ctx context.Context) (ArtistAlbumsResults, error) {
+	if !results.HasNext {
+		return ArtistAlbumsResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.getArtistAlbums(ctx, results.artistID, results.searchOptions)
+}
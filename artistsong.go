@@ -0,0 +1,77 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+)
+
+// Artist songs results.
+type ArtistSongsResults struct {
+	Page    int
+	Size    int
+	Total   int
+	HasNext bool
+	Songs   []Song
+
+	// for next
+	c             *Client
+	artistID      string
+	searchOptions *searchOptions
+}
+
+// Artist songs API response.
+type artistSongsAPIResponse struct {
+	ArtistID string `json:"artistId"`
+	Name     string `json:"name"`
+	TopSongs struct {
+		Songs    []songAPIResponse `json:"songs"`
+		Total    int               `json:"total"`
+		LastPage bool              `json:"last_page"`
+	} `json:"topSongs"`
+}
+
+func (resp *artistSongsAPIResponse) count() int {
+	return len(resp.TopSongs.Songs)
+}
+
+func (resp *artistSongsAPIResponse) toResults(c *Client, artistID string, opts *searchOptions) (ArtistSongsResults, error) {
+	songs := make([]Song, 0)
+
+	for _, result := range resp.TopSongs.Songs {
+		songs = append(songs, result.toSong())
+	}
+
+	offset := (opts.page - 1) * opts.limit
+	// an empty page has no further results, whatever the reported total
+	hasNext := len(songs) > 0 && !resp.TopSongs.LastPage && offset+len(songs) < resp.TopSongs.Total
+	if !hasNext {
+		return ArtistSongsResults{
+			Page:    opts.page,
+			Size:    len(songs),
+			Total:   resp.TopSongs.Total,
+			HasNext: hasNext,
+			Songs:   songs,
+		}, nil
+	}
+
+	return ArtistSongsResults{
+		Page:          opts.page,
+		Size:          len(songs),
+		Total:         resp.TopSongs.Total,
+		HasNext:       hasNext,
+		Songs:         songs,
+		c:             c,
+		artistID:      artistID,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *ArtistSongsResults) Next(ctx context.Context) (ArtistSongsResults, error) {
+	if !results.HasNext {
+		return ArtistSongsResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.getArtistSongs(ctx, results.artistID, results.searchOptions)
+}
@@ -0,0 +1,217 @@
+package jiosaavn
+
+import (
+	"html"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Autocomplete results.
+type AutocompleteResults struct {
+	TopQuery  AutocompleteGroup[TopQueryResult]
+	Songs     AutocompleteGroup[Song]
+	Albums    AutocompleteGroup[Album]
+	Artists   AutocompleteGroup[Artist]
+	Playlists AutocompleteGroup[Playlist]
+	Shows     AutocompleteGroup[Show]
+}
+
+// AutocompleteGroup is a group of autocomplete results.
+// Position is the rank of the group among the other groups, starting at 1,
+// and Items are ordered by their rank within the group.
+type AutocompleteGroup[T any] struct {
+	Position int
+	Items    []AutocompleteItem[T]
+}
+
+// AutocompleteItem is an autocomplete result,
+// Position is its rank within the group, starting at 1.
+type AutocompleteItem[T any] struct {
+	Position int
+	Value    T
+}
+
+// TopQueryResult is a top query result,
+// only the field matching Type is set.
+type TopQueryResult struct {
+	Type     string
+	Song     *Song
+	Album    *Album
+	Artist   *Artist
+	Playlist *Playlist
+	Show     *Show
+}
+
+// Autocomplete API Response.
+type autocompleteAPIResponse struct {
+	TopQuery  autocompleteGroupAPIResponse `json:"topquery"`
+	Songs     autocompleteGroupAPIResponse `json:"songs"`
+	Albums    autocompleteGroupAPIResponse `json:"albums"`
+	Artists   autocompleteGroupAPIResponse `json:"artists"`
+	Playlists autocompleteGroupAPIResponse `json:"playlists"`
+	Shows     autocompleteGroupAPIResponse `json:"shows"`
+}
+
+type autocompleteGroupAPIResponse struct {
+	Data     []autocompleteItemAPIResponse `json:"data"`
+	Position int                           `json:"position"`
+}
+
+// Autocomplete item API Response, shared by all entity types.
+type autocompleteItemAPIResponse struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	Image        string `json:"image"`
+	Album        string `json:"album"`
+	Music        string `json:"music"`
+	URL          string `json:"url"`
+	Type         string `json:"type"`
+	Description  string `json:"description"`
+	Language     string `json:"language"`
+	Position     int    `json:"position"`
+	SeasonNumber int    `json:"season_number"`
+	MoreInfo     struct {
+		Year           string `json:"year"`
+		Language       string `json:"language"`
+		PrimaryArtists string `json:"primary_artists"`
+		Singers        string `json:"singers"`
+		SongPids       string `json:"song_pids"`
+	} `json:"more_info"`
+}
+
+func (res *autocompleteAPIResponse) count() int {
+	return len(res.TopQuery.Data) + len(res.Songs.Data) + len(res.Albums.Data) +
+		len(res.Artists.Data) + len(res.Playlists.Data) + len(res.Shows.Data)
+}
+
+func (res *autocompleteAPIResponse) toResults() AutocompleteResults {
+	return AutocompleteResults{
+		TopQuery:  toAutocompleteGroup(res.TopQuery, (*autocompleteItemAPIResponse).toTopQueryResult),
+		Songs:     toAutocompleteGroup(res.Songs, (*autocompleteItemAPIResponse).toSong),
+		Albums:    toAutocompleteGroup(res.Albums, (*autocompleteItemAPIResponse).toAlbum),
+		Artists:   toAutocompleteGroup(res.Artists, (*autocompleteItemAPIResponse).toArtist),
+		Playlists: toAutocompleteGroup(res.Playlists, (*autocompleteItemAPIResponse).toPlaylist),
+		Shows:     toAutocompleteGroup(res.Shows, (*autocompleteItemAPIResponse).toShow),
+	}
+}
+
+func toAutocompleteGroup[T any](group autocompleteGroupAPIResponse, convert func(*autocompleteItemAPIResponse) T) AutocompleteGroup[T] {
+	data := group.Data
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Position < data[j].Position
+	})
+
+	items := make([]AutocompleteItem[T], 0)
+	for i := range data {
+		items = append(items, AutocompleteItem[T]{
+			Position: data[i].Position,
+			Value:    convert(&data[i]),
+		})
+	}
+
+	return AutocompleteGroup[T]{
+		Position: group.Position,
+		Items:    items,
+	}
+}
+
+func (res *autocompleteItemAPIResponse) toTopQueryResult() TopQueryResult {
+	item := TopQueryResult{Type: res.Type}
+	switch res.Type {
+	case "song":
+		song := res.toSong()
+		item.Song = &song
+	case "album":
+		album := res.toAlbum()
+		item.Album = &album
+	case "artist":
+		artist := res.toArtist()
+		item.Artist = &artist
+	case "playlist":
+		playlist := res.toPlaylist()
+		item.Playlist = &playlist
+	case "show":
+		show := res.toShow()
+		item.Show = &show
+	}
+
+	return item
+}
+
+func (res *autocompleteItemAPIResponse) toSong() Song {
+	return Song{
+		ID:             res.ID,
+		Title:          html.UnescapeString(res.Title),
+		Subtitle:       html.UnescapeString(res.Description),
+		PermanentURL:   res.URL,
+		Image:          res.Image,
+		Language:       res.MoreInfo.Language,
+		AlbumName:      html.UnescapeString(res.Album),
+		PrimaryArtists: parseArtistNames(res.MoreInfo.PrimaryArtists),
+	}
+}
+
+func (res *autocompleteItemAPIResponse) toAlbum() Album {
+	year, _ := strconv.Atoi(res.MoreInfo.Year)
+	songCount := 0
+	if len(res.MoreInfo.SongPids) > 0 {
+		songCount = len(strings.Split(res.MoreInfo.SongPids, ","))
+	}
+
+	return Album{
+		ID:             res.ID,
+		Title:          html.UnescapeString(res.Title),
+		Subtitle:       html.UnescapeString(res.Description),
+		PermanentURL:   res.URL,
+		Image:          res.Image,
+		Language:       res.MoreInfo.Language,
+		Year:           year,
+		SongCount:      songCount,
+		PrimaryArtists: parseArtistNames(res.Music),
+	}
+}
+
+func (res *autocompleteItemAPIResponse) toArtist() Artist {
+	return Artist{
+		ID:           res.ID,
+		Name:         html.UnescapeString(res.Title),
+		Image:        res.Image,
+		PermanentURL: res.URL,
+	}
+}
+
+func (res *autocompleteItemAPIResponse) toPlaylist() Playlist {
+	return Playlist{
+		ID:           res.ID,
+		Title:        html.UnescapeString(res.Title),
+		Image:        res.Image,
+		PermanentURL: res.URL,
+		Language:     res.Language,
+	}
+}
+
+func (res *autocompleteItemAPIResponse) toShow() Show {
+	return Show{
+		ID:           res.ID,
+		Title:        html.UnescapeString(res.Title),
+		Subtitle:     html.UnescapeString(res.Description),
+		PermanentURL: res.URL,
+		Image:        res.Image,
+		Language:     res.Language,
+		SeasonNumber: res.SeasonNumber,
+	}
+}
+
+// parseArtistNames parses a comma separated list of artist names
+func parseArtistNames(names string) []Artist {
+	artists := make([]Artist, 0)
+	for _, name := range strings.Split(html.UnescapeString(names), ",") {
+		name = strings.TrimSpace(name)
+		if len(name) > 0 {
+			artists = append(artists, Artist{Name: name})
+		}
+	}
+
+	return artists
+}
@@ -0,0 +1,206 @@
+package jiosaavn
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// endpoints without a configured ttl are not cached
+const defaultCacheTTL = 0
+
+// Cache stores raw api responses keyed on the normalized request parameters.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	Get(ctx context.Context, key string) (CacheEntry, bool)
+	Set(ctx context.Context, key string, entry CacheEntry)
+}
+
+// CacheEntry is a cached api response.
+// It is served as is until FreshUntil, and served while being
+// revalidated in the background until ExpiresAt.
+type CacheEntry struct {
+	Body       []byte
+	FreshUntil time.Time
+	ExpiresAt  time.Time
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context for which cached responses are ignored.
+// Fresh responses are still written to the cache.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func isCacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// defaultCacheTTLs returns the default time to live per endpoint
+func defaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		// details rarely change
+		getSongById:        24 * time.Hour,
+		getAlbumById:       24 * time.Hour,
+		getPlaylistById:    time.Hour,
+		getArtistById:      6 * time.Hour,
+		getLyricsEndpoint:  24 * time.Hour,
+		resolveURLEndpoint: 6 * time.Hour,
+		getShowById:        6 * time.Hour,
+		getEpisodeById:     24 * time.Hour,
+
+		// podcasts
+		getShowEpisodesEndpoint: time.Hour,
+
+		// users
+		getUserPlaylistsEndpoint: time.Hour,
+
+		// recommendations
+		getRecommendationsEndpoint: time.Hour,
+
+		// browse
+		getChartsEndpoint:            time.Hour,
+		getFeaturedPlaylistsEndpoint: time.Hour,
+		getNewReleasesEndpoint:       time.Hour,
+		getLaunchDataEndpoint:        15 * time.Minute,
+
+		// radio stations are never cached
+		createFeaturedStationEndpoint: 0,
+		createArtistStationEndpoint:   0,
+		createEntityStationEndpoint:   0,
+		getStationSongsEndpoint:       0,
+
+		// artist listings
+		getArtistSongsEndpoint:  time.Hour,
+		getArtistAlbumsEndpoint: time.Hour,
+
+		// search
+		searchSongsEndpoint:     5 * time.Minute,
+		searchArtistsEndpoint:   5 * time.Minute,
+		searchPlaylistsEndpoint: 5 * time.Minute,
+		searchAlbumsEndpoint:    5 * time.Minute,
+		searchPodcastsEndpoint:  5 * time.Minute,
+		autocompleteEndpoint:    5 * time.Minute,
+	}
+}
+
+// WithCache enables caching of api responses
+func WithCache(cache Cache) ClientOption {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL sets the time to live of cached responses of the given __call endpoint,
+// e.g. "content.getAlbumDetails". A zero ttl disables caching of the endpoint.
+func WithCacheTTL(endpoint string, ttl time.Duration) ClientOption {
+	return func(c *Client) {
+		c.cacheTTLs[endpoint] = ttl
+	}
+}
+
+// WithStaleWhileRevalidate serves expired responses for up to d
+// while they are refreshed in the background
+func WithStaleWhileRevalidate(d time.Duration) ClientOption {
+	return func(c *Client) {
+		c.staleWhileRevalidate = d
+	}
+}
+
+func (c *Client) cacheTTL(endpoint string) time.Duration {
+	ttl, ok := c.cacheTTLs[endpoint]
+	if !ok {
+		return defaultCacheTTL
+	}
+
+	return ttl
+}
+
+// cached serves the call from the cache, falling back to the api
+func (c *Client) cached(ctx context.Context, call *Call) error {
+	ttl := c.cacheTTL(call.Params[callEndpoint])
+	if ttl <= 0 {
+		_, err := c.fetch(ctx, call)
+		return err
+	}
+
+	key := c.cacheKey(call)
+	call.CacheStatus = CacheBypass
+	if !isCacheBypassed(ctx) {
+		call.CacheStatus = CacheMiss
+		entry, ok := c.cache.Get(ctx, key)
+		now := time.Now()
+		if ok && now.Before(entry.ExpiresAt) {
+			err := decodeResponse(http.StatusOK, call.Params, entry.Body, call.Result)
+			if err == nil {
+				call.StatusCode = http.StatusOK
+				call.BytesRead = len(entry.Body)
+				call.CacheStatus = CacheHit
+				if !now.Before(entry.FreshUntil) {
+					call.CacheStatus = CacheStale
+					c.revalidate(ctx, key, call)
+				}
+				return nil
+			}
+		}
+	}
+
+	return c.fetchAndStore(ctx, key, call)
+}
+
+func (c *Client) fetchAndStore(ctx context.Context, key string, call *Call) error {
+	body, err := c.fetch(ctx, call)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	ttl := c.cacheTTL(call.Params[callEndpoint])
+	c.cache.Set(ctx, key, CacheEntry{
+		Body:       body,
+		FreshUntil: now.Add(ttl),
+		ExpiresAt:  now.Add(ttl + c.staleWhileRevalidate),
+	})
+
+	return nil
+}
+
+// revalidate refreshes a stale entry in the background, once per key at a time
+func (c *Client) revalidate(ctx context.Context, key string, call *Call) {
+	if _, loaded := c.revalidating.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	fresh := *call
+	fresh.Result = reflect.New(reflect.TypeOf(call.Result).Elem()).Interface()
+	go func() {
+		defer c.revalidating.Delete(key)
+		_ = c.fetchAndStore(ctx, key, &fresh)
+	}()
+}
+
+// cacheKey returns the normalized request url and cookies of call,
+// as cookies such as the language preference change the response
+func (c *Client) cacheKey(call *Call) string {
+	key := c.baseURL + "?" + c.query(call.Params).Encode()
+
+	cookies := make([]string, 0)
+	for _, cookie := range c.cookies {
+		cookies = append(cookies, cookie.Name+"="+cookie.Value)
+	}
+	for _, header := range []http.Header{c.header, call.Header} {
+		cookies = append(cookies, header.Values("Cookie")...)
+	}
+	if len(cookies) == 0 {
+		return key
+	}
+
+	sort.Strings(cookies)
+	return key + "#" + strings.Join(cookies, "; ")
+}
@@ -0,0 +1,58 @@
+package jiosaavn
+
+// Get Charts API Response.
+type getChartsAPIResponse []playlistAPIResponse
+
+func (res *getChartsAPIResponse) count() int {
+	return len(*res)
+}
+
+// toPlaylists returns all charts, or the requested page of charts when
+// paginated with WithPage or WithLimit, as charts aren't paginated by the api
+func (res *getChartsAPIResponse) toPlaylists(opts *searchOptions) []Playlist {
+	charts := make([]Playlist, 0)
+	for _, result := range *res {
+		chart := result.toPlaylist()
+		if !matchesLanguage(chart.Language, opts.languages) {
+			continue
+		}
+
+		charts = append(charts, chart)
+	}
+
+	if opts.applied&paginationOptions == 0 {
+		return charts
+	}
+
+	start := (opts.page - 1) * opts.limit
+	if start >= len(charts) {
+		return make([]Playlist, 0)
+	}
+
+	end := start + opts.limit
+	if end > len(charts) {
+		end = len(charts)
+	}
+
+	return charts[start:end]
+}
+
+// Get Featured Playlists API Response.
+type getFeaturedPlaylistsAPIResponse struct {
+	Data     []playlistAPIResponse `json:"data"`
+	Count    int                   `json:"count"`
+	LastPage bool                  `json:"last_page"`
+}
+
+func (res *getFeaturedPlaylistsAPIResponse) count() int {
+	return len(res.Data)
+}
+
+func (res *getFeaturedPlaylistsAPIResponse) toPlaylists() []Playlist {
+	playlists := make([]Playlist, 0)
+	for _, result := range res.Data {
+		playlists = append(playlists, result.toPlaylist())
+	}
+
+	return playlists
+}
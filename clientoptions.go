@@ -0,0 +1,67 @@
+package jiosaavn
+
+import (
+	"net/http"
+	"strings"
+)
+
+// API contexts
+const (
+	APIContextWeb     = "web6dot0"
+	APIContextWap     = "wap6dot0"
+	APIContextAndroid = "android"
+)
+
+// Client Option
+type ClientOption func(c *Client)
+
+// WithBaseURL sets the api endpoint the client talks to
+func WithBaseURL(u string) ClientOption {
+	return func(c *Client) {
+		u = strings.TrimSpace(u)
+		if len(u) > 0 {
+			c.baseURL = u
+		}
+	}
+}
+
+// WithAPIContext sets the ctx query parameter sent with every request
+func WithAPIContext(apiContext string) ClientOption {
+	return func(c *Client) {
+		apiContext = strings.TrimSpace(apiContext)
+		if len(apiContext) > 0 {
+			c.apiContext = apiContext
+		}
+	}
+}
+
+// WithAPIVersion sets the api_version query parameter sent with every request
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) {
+		version = strings.TrimSpace(version)
+		if len(version) > 0 {
+			c.apiVersion = version
+		}
+	}
+}
+
+// WithHeader adds a header sent with every request
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.header.Add(key, value)
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.header.Set("User-Agent", userAgent)
+	}
+}
+
+// WithCookies adds cookies sent with every request
+func WithCookies(cookies ...*http.Cookie) ClientOption {
+	return func(c *Client) {
+		c.cookies = append(c.cookies, cookies...)
+	}
+}
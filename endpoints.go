@@ -1,5 +1,7 @@
 package jiosaavn
 
+import "context"
+
 // endpoints
 const (
 	// search
@@ -12,4 +14,97 @@ const (
 	getSongById     = "song.getDetails"
 	getPlaylistById = "playlist.getDetails"
 	getAlbumById    = "content.getAlbumDetails"
+	getArtistById   = "artist.getArtistPageDetails"
+
+	// artist listings
+	getArtistSongsEndpoint  = "artist.getArtistMoreSong"
+	getArtistAlbumsEndpoint = "artist.getArtistMoreAlbum"
+
+	// lyrics
+	getLyricsEndpoint = "lyrics.getLyrics"
+
+	// autocomplete
+	autocompleteEndpoint = "autocomplete.get"
+
+	// recommendations
+	getRecommendationsEndpoint = "reco.getreco"
+
+	// radio
+	createFeaturedStationEndpoint = "webradio.createFeaturedStation"
+	createArtistStationEndpoint   = "webradio.createArtistStation"
+	createEntityStationEndpoint   = "webradio.createEntityStation"
+	getStationSongsEndpoint       = "webradio.getSong"
+
+	// browse
+	getChartsEndpoint            = "content.getCharts"
+	getFeaturedPlaylistsEndpoint = "content.getFeaturedPlaylists"
+	getNewReleasesEndpoint       = "content.getAlbums"
+	getLaunchDataEndpoint        = "webapi.getLaunchData"
+
+	// urls
+	resolveURLEndpoint = "webapi.get"
+
+	// labels are served by webapi.get too
+	getLabelEndpoint = resolveURLEndpoint
+
+	// podcasts
+	searchPodcastsEndpoint  = "search.getShowResults"
+	getShowById             = "show.getHomePage"
+	getShowEpisodesEndpoint = "show.getAllEpisodes"
+	getEpisodeById          = "episode.getDetails"
+
+	// users
+	getUserPlaylistsEndpoint = "user.getPlaylists"
 )
+
+// operations maps endpoints to the client methods calling them
+var operations = map[string]string{
+	searchSongsEndpoint:           "SearchSongs",
+	searchArtistsEndpoint:         "SearchArtists",
+	searchPlaylistsEndpoint:       "SearchPlaylists",
+	searchAlbumsEndpoint:          "SearchAlbums",
+	getSongById:                   "GetSongById",
+	getPlaylistById:               "GetPlaylistById",
+	getAlbumById:                  "GetAlbumById",
+	getArtistById:                 "GetArtistById",
+	getArtistSongsEndpoint:        "GetArtistSongs",
+	getArtistAlbumsEndpoint:       "GetArtistAlbums",
+	getLyricsEndpoint:             "GetLyrics",
+	autocompleteEndpoint:          "Autocomplete",
+	getRecommendationsEndpoint:    "GetSongRecommendations",
+	createFeaturedStationEndpoint: "CreateStation",
+	createArtistStationEndpoint:   "CreateStation",
+	createEntityStationEndpoint:   "CreateStation",
+	getStationSongsEndpoint:       "Station.NextSongs",
+	getChartsEndpoint:             "GetCharts",
+	getFeaturedPlaylistsEndpoint:  "GetTopPlaylists",
+	getNewReleasesEndpoint:        "GetNewReleases",
+	getLaunchDataEndpoint:         "GetHome",
+	resolveURLEndpoint:            "ResolveURL",
+	searchPodcastsEndpoint:        "SearchPodcasts",
+	getShowById:                   "GetShowById",
+	getShowEpisodesEndpoint:       "GetShowEpisodes",
+	getEpisodeById:                "GetEpisodeById",
+	getUserPlaylistsEndpoint:      "GetUserPlaylists",
+}
+
+type operationKey struct{}
+
+// withOperation returns a context naming the calls made with it after operation,
+// for methods calling the endpoints of others. The outermost operation wins.
+func withOperation(ctx context.Context, operation string) context.Context {
+	if _, ok := ctx.Value(operationKey{}).(string); ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operationOf returns the client method of the call with params
+func operationOf(ctx context.Context, params map[string]string) string {
+	if operation, ok := ctx.Value(operationKey{}).(string); ok {
+		return operation
+	}
+
+	return operations[params[callEndpoint]]
+}
@@ -0,0 +1,168 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strconv"
+	"time"
+)
+
+// Episode.
+type Episode struct {
+	ID              string
+	Title           string
+	Subtitle        string
+	Description     string
+	PermanentURL    string
+	Image           string
+	Language        string
+	PlayCount       int
+	ExplicitContent bool
+	MediaURL        string
+	Duration        int
+	ReleaseDate     time.Time
+	ShowID          string
+	ShowTitle       string
+	SeasonNumber    int
+	EpisodeNumber   int
+}
+
+// Episode API Response.
+type episodeAPIResponse struct {
+	ID              string `json:"id"`
+	Title           string `json:"title"`
+	Subtitle        string `json:"subtitle"`
+	Type            string `json:"type"`
+	PermaURL        string `json:"perma_url"`
+	Image           string `json:"image"`
+	Language        string `json:"language"`
+	PlayCount       string `json:"play_count"`
+	ExplicitContent string `json:"explicit_content"`
+	MoreInfo        struct {
+		Description       string `json:"description"`
+		Duration          string `json:"duration"`
+		ReleaseDate       string `json:"release_date"`
+		EncryptedMediaURL string `json:"encrypted_media_url"`
+		ShowID            string `json:"show_id"`
+		ShowTitle         string `json:"show_title"`
+		SeasonNo          string `json:"season_no"`
+		EpisodeNumber     string `json:"episode_number"`
+	} `json:"more_info"`
+}
+
+func (res *episodeAPIResponse) toEpisode() Episode {
+	playCount, _ := strconv.Atoi(res.PlayCount)
+	duration, _ := strconv.Atoi(res.MoreInfo.Duration)
+	seasonNumber, _ := strconv.Atoi(res.MoreInfo.SeasonNo)
+	episodeNumber, _ := strconv.Atoi(res.MoreInfo.EpisodeNumber)
+	releaseDate, _ := time.Parse(time.DateOnly, res.MoreInfo.ReleaseDate)
+	mediaURL, _ := generateMediaURL(res.MoreInfo.EncryptedMediaURL)
+
+	return Episode{
+		ID:              res.ID,
+		Title:           html.UnescapeString(res.Title),
+		Subtitle:        html.UnescapeString(res.Subtitle),
+		Description:     html.UnescapeString(res.MoreInfo.Description),
+		PermanentURL:    res.PermaURL,
+		Image:           res.Image,
+		Language:        res.Language,
+		PlayCount:       playCount,
+		ExplicitContent: res.ExplicitContent == "1",
+		MediaURL:        mediaURL,
+		Duration:        duration,
+		ReleaseDate:     releaseDate,
+		ShowID:          res.MoreInfo.ShowID,
+		ShowTitle:       html.UnescapeString(res.MoreInfo.ShowTitle),
+		SeasonNumber:    seasonNumber,
+		EpisodeNumber:   episodeNumber,
+	}
+}
+
+// Get Episode API Response.
+type getEpisodeAPIResponse struct {
+	Episodes []episodeAPIResponse `json:"episodes"`
+}
+
+func (res *getEpisodeAPIResponse) count() int {
+	return len(res.Episodes)
+}
+
+func (res *getEpisodeAPIResponse) validate() error {
+	if len(res.Episodes) == 0 {
+		return fmt.Errorf("invalid episode id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getEpisodeAPIResponse) toEpisode() (Episode, error) {
+	err := res.validate()
+	if err != nil {
+		return Episode{}, err
+	}
+
+	return res.Episodes[0].toEpisode(), nil
+}
+
+// Show episodes results.
+type ShowEpisodesResults struct {
+	Page     int
+	Size     int
+	HasNext  bool
+	Episodes []Episode
+
+	// for next
+	c             *Client
+	showID        string
+	season        int
+	searchOptions *searchOptions
+}
+
+// Show Episodes API Response.
+type showEpisodesAPIResponse []episodeAPIResponse
+
+func (res *showEpisodesAPIResponse) count() int {
+	return len(*res)
+}
+
+// toResults returns the episodes page, the api doesn't return the total
+// so further results are assumed while pages are full
+func (res *showEpisodesAPIResponse) toResults(c *Client, showID string, season int, opts *searchOptions) (ShowEpisodesResults, error) {
+	episodes := make([]Episode, 0)
+
+	for _, result := range *res {
+		episodes = append(episodes, result.toEpisode())
+	}
+
+	hasNext := len(episodes) == opts.limit
+	if !hasNext {
+		return ShowEpisodesResults{
+			Page:     opts.page,
+			Size:     len(episodes),
+			HasNext:  hasNext,
+			Episodes: episodes,
+		}, nil
+	}
+
+	return ShowEpisodesResults{
+		Page:          opts.page,
+		Size:          len(episodes),
+		HasNext:       hasNext,
+		Episodes:      episodes,
+		c:             c,
+		showID:        showID,
+		season:        season,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *ShowEpisodesResults) Next(ctx context.Context) (ShowEpisodesResults, error) {
+	if !results.HasNext {
+		return ShowEpisodesResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.getShowEpisodes(ctx, results.showID, results.season, results.searchOptions)
+}
@@ -0,0 +1,83 @@
+package jiosaavn
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maximum number of response body bytes kept on an APIError
+const maxErrorBodySize = 512
+
+// errors
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrUnexpectedResponse = errors.New("unexpected response")
+)
+
+// APIError describes a failed call to the JioSaavn API.
+// It wraps one of the sentinel errors so it can be matched with errors.Is.
+// Code and Message are set when JioSaavn reports the error in the response body,
+// RetryAfter when the response carried a Retry-After header.
+type APIError struct {
+	StatusCode int
+	Endpoint   string
+	Params     map[string]string
+	Code       string
+	Message    string
+	Body       string
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	if len(e.Code) > 0 || len(e.Message) > 0 {
+		detail := strings.TrimSpace(e.Code + " " + e.Message)
+		return fmt.Sprintf("jiosaavn: %s: status %d: %v: %s", e.Endpoint, e.StatusCode, e.Err, detail)
+	}
+
+	return fmt.Sprintf("jiosaavn: %s: status %d: %v", e.Endpoint, e.StatusCode, e.Err)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+func newAPIError(statusCode int, params map[string]string, body []byte, err error) *APIError {
+	p := make(map[string]string, len(params))
+	for k, v := range params {
+		p[k] = v
+	}
+
+	if len(body) > maxErrorBodySize {
+		body = body[:maxErrorBodySize]
+	}
+
+	return &APIError{
+		StatusCode: statusCode,
+		Endpoint:   params[callEndpoint],
+		Params:     p,
+		Body:       string(body),
+		Err:        err,
+	}
+}
+
+// statusError maps the status code to a sentinel error, nil for successful responses
+func statusError(statusCode int) error {
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return nil
+	case statusCode == http.StatusNotFound:
+		return ErrNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return ErrInvalidArgument
+	default:
+		return ErrUnexpectedResponse
+	}
+}
@@ -0,0 +1,80 @@
+package jiosaavn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache is a Cache storing every response as a file in a directory.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache returns a cache storing responses in dir, creating it if needed
+func NewFileCache(dir string) (*FileCache, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir}, nil
+}
+
+// Get returns the entry stored for key
+func (c *FileCache) Get(ctx context.Context, key string) (CacheEntry, bool) {
+	path := c.path(key)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return CacheEntry{}, false
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		os.Remove(path)
+		return CacheEntry{}, false
+	}
+
+	if !time.Now().Before(entry.ExpiresAt) {
+		os.Remove(path)
+		return CacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// Set stores the entry for key
+func (c *FileCache) Set(ctx context.Context, key string, entry CacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// write to a temporary file first so readers never see partial entries
+	f, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return
+	}
+
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		os.Remove(f.Name())
+	}
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -0,0 +1,202 @@
+package jiosaavn
+
+import (
+	"encoding/json"
+	"html"
+	"sort"
+)
+
+// Home Module, e.g. trending or top charts.
+type HomeModule struct {
+	Source   string
+	Title    string
+	Subtitle string
+	Position int
+	Items    []HomeItem
+}
+
+// HomeItem is an item of a home module,
+// only the field matching Type is set.
+// Items of unsupported types, or which couldn't be decoded, are kept in Raw.
+type HomeItem struct {
+	Type     string
+	Song     *Song
+	Album    *Album
+	Playlist *Playlist
+	Artist   *Artist
+	Station  *RadioStation
+	Raw      json.RawMessage
+}
+
+// Get Launch Data API Response, module items are keyed on the module source.
+type getLaunchDataAPIResponse struct {
+	Modules map[string]launchModuleAPIResponse
+	Items   map[string][]json.RawMessage
+}
+
+type launchModuleAPIResponse struct {
+	Source   string `json:"source"`
+	Position int    `json:"position"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+}
+
+// Launch item API Response, only the type is decoded upfront.
+type launchItemAPIResponse struct {
+	Type string `json:"type"`
+}
+
+// Radio station API Response.
+type radioStationAPIResponse struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Image    string `json:"image"`
+	MoreInfo struct {
+		Description         string `json:"description"`
+		FeaturedStationType string `json:"featured_station_type"`
+		Query               string `json:"query"`
+		Language            string `json:"language"`
+	} `json:"more_info"`
+}
+
+func (res *getLaunchDataAPIResponse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	res.Modules = make(map[string]launchModuleAPIResponse)
+	if modules, ok := fields["modules"]; ok {
+		if err := json.Unmarshal(modules, &res.Modules); err != nil {
+			return err
+		}
+	}
+
+	// module data which isn't a list, e.g. global_config, is skipped
+	res.Items = make(map[string][]json.RawMessage)
+	for key, value := range fields {
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err == nil {
+			res.Items[key] = items
+		}
+	}
+
+	return nil
+}
+
+func (res *getLaunchDataAPIResponse) count() int {
+	return len(res.Modules)
+}
+
+func (res *getLaunchDataAPIResponse) toModules() []HomeModule {
+	modules := make([]HomeModule, 0)
+	for key, module := range res.Modules {
+		source := module.Source
+		if len(source) == 0 {
+			source = key
+		}
+
+		items := make([]HomeItem, 0)
+		for _, raw := range res.Items[source] {
+			items = append(items, toHomeItem(raw))
+		}
+
+		modules = append(modules, HomeModule{
+			Source:   source,
+			Title:    html.UnescapeString(module.Title),
+			Subtitle: html.UnescapeString(module.Subtitle),
+			Position: module.Position,
+			Items:    items,
+		})
+	}
+
+	sort.SliceStable(modules, func(i, j int) bool {
+		if modules[i].Position == modules[j].Position {
+			return modules[i].Source < modules[j].Source
+		}
+
+		return modules[i].Position < modules[j].Position
+	})
+
+	return modules
+}
+
+// toHomeItem decodes an item based on its type,
+// keeping it raw if its type is unsupported or it can't be decoded
+func toHomeItem(raw json.RawMessage) HomeItem {
+	var entity launchItemAPIResponse
+	if err := json.Unmarshal(raw, &entity); err != nil {
+		return HomeItem{Raw: raw}
+	}
+
+	item := HomeItem{Type: entity.Type}
+	undecoded := HomeItem{Type: entity.Type, Raw: raw}
+	switch entity.Type {
+	case "song":
+		res := new(songAPIResponse)
+		if err := json.Unmarshal(raw, res); err != nil {
+			return undecoded
+		}
+		song := res.toSong()
+		item.Song = &song
+	case "album":
+		res := new(getAlbumAPIResponse)
+		if err := json.Unmarshal(raw, res); err != nil {
+			return undecoded
+		}
+		album := res.toAlbum()
+		item.Album = &album
+	case "playlist":
+		res := new(playlistAPIResponse)
+		if err := json.Unmarshal(raw, res); err != nil {
+			return undecoded
+		}
+		playlist := res.toPlaylist()
+		item.Playlist = &playlist
+	case "artist":
+		res := new(struct {
+			artistAPIResponse
+			Title string `json:"title"`
+		})
+		if err := json.Unmarshal(raw, res); err != nil {
+			return undecoded
+		}
+		if len(res.Name) == 0 {
+			res.Name = res.Title
+		}
+		artist := res.toArtist()
+		item.Artist = &artist
+	case "radio_station":
+		res := new(radioStationAPIResponse)
+		if err := json.Unmarshal(raw, res); err != nil {
+			return undecoded
+		}
+		station := res.toRadioStation()
+		item.Station = &station
+	default:
+		item.Raw = raw
+	}
+
+	return item
+}
+
+func (res *radioStationAPIResponse) toRadioStation() RadioStation {
+	name := res.MoreInfo.Query
+	if len(name) == 0 {
+		name = res.Title
+	}
+
+	seed := FeaturedStation(name, res.MoreInfo.Language)
+	if res.MoreInfo.FeaturedStationType == "artist" {
+		seed = ArtistStation(name, res.MoreInfo.Language)
+	}
+
+	return RadioStation{
+		Title:    html.UnescapeString(res.Title),
+		Subtitle: html.UnescapeString(res.Subtitle),
+		Image:    res.Image,
+		Language: res.MoreInfo.Language,
+		Seed:     seed,
+	}
+}
@@ -2,31 +2,75 @@ package jiosaavn
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 // constants
 const (
-	baseURL      = "https://www.jiosaavn.com/api.php"
-	callEndpoint = "__call"
+	defaultBaseURL    = "https://www.jiosaavn.com/api.php"
+	defaultAPIVersion = "4"
+	callEndpoint      = "__call"
+	siteURL           = "https://www.jiosaavn.com"
+	artistPageSize    = 10
 )
 
 // Client.
 type Client struct {
 	httpClient *http.Client
+	baseURL    string
+	apiContext string
+	apiVersion string
+	header     http.Header
+	cookies    []*http.Cookie
+
+	retryPolicy      RetryPolicy
+	limiter          Limiter
+	endpointLimiters map[string]Limiter
+
+	cache                Cache
+	cacheTTLs            map[string]time.Duration
+	staleWhileRevalidate time.Duration
+	revalidating         sync.Map
+
+	middlewares []Middleware
+	handler     Handler
+	logger      *slog.Logger
 }
 
 // NewClient returns a new JioSaavn client
-func NewClient(c *http.Client) *Client {
+func NewClient(c *http.Client, opts ...ClientOption) *Client {
 	if c == nil {
 		c = &http.Client{}
 	}
 
-	return &Client{c}
+	client := &Client{
+		httpClient: c,
+		baseURL:    defaultBaseURL,
+		apiContext: APIContextWeb,
+		apiVersion: defaultAPIVersion,
+		header:     make(http.Header),
+		cacheTTLs:  defaultCacheTTLs(),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+	middlewares := client.middlewares
+	if client.logger != nil {
+		middlewares = append(append([]Middleware{}, middlewares...), loggingMiddleware(client.logger))
+	}
+	client.handler = chain(client.execute, middlewares)
+
+	return client
 }
 
 // SearchSongs
@@ -36,6 +80,11 @@ func (c *Client) SearchSongs(ctx context.Context, q string, opts ...SearchOption
 		opt(searchOpts)
 	}
 
+	err := searchOpts.allow("SearchSongs", paginationOptions)
+	if err != nil {
+		return SearchSongsResults{}, c.reject(ctx, searchSongsEndpoint, err)
+	}
+
 	return c.searchSongs(ctx, q, searchOpts)
 }
 
@@ -46,6 +95,11 @@ func (c *Client) SearchArtists(ctx context.Context, q string, opts ...SearchOpti
 		opt(searchOpts)
 	}
 
+	err := searchOpts.allow("SearchArtists", paginationOptions)
+	if err != nil {
+		return SearchArtistsResults{}, c.reject(ctx, searchArtistsEndpoint, err)
+	}
+
 	return c.searchArtists(ctx, q, searchOpts)
 }
 
@@ -56,6 +110,11 @@ func (c *Client) SearchPlaylists(ctx context.Context, q string, opts ...SearchOp
 		opt(searchOpts)
 	}
 
+	err := searchOpts.allow("SearchPlaylists", paginationOptions)
+	if err != nil {
+		return SearchPlaylistsResults{}, c.reject(ctx, searchPlaylistsEndpoint, err)
+	}
+
 	return c.searchPlaylists(ctx, q, searchOpts)
 }
 
@@ -66,14 +125,54 @@ func (c *Client) SearchAlbums(ctx context.Context, q string, opts ...SearchOptio
 		opt(searchOpts)
 	}
 
+	err := searchOpts.allow("SearchAlbums", paginationOptions)
+	if err != nil {
+		return SearchAlbumsResults{}, c.reject(ctx, searchAlbumsEndpoint, err)
+	}
+
 	return c.searchAlbums(ctx, q, searchOpts)
 }
 
+// SearchPodcasts
+func (c *Client) SearchPodcasts(ctx context.Context, q string, opts ...SearchOption) (SearchPodcastsResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("SearchPodcasts", paginationOptions)
+	if err != nil {
+		return SearchPodcastsResults{}, c.reject(ctx, searchPodcastsEndpoint, err)
+	}
+
+	return c.searchPodcasts(ctx, q, searchOpts)
+}
+
+// Autocomplete
+func (c *Client) Autocomplete(ctx context.Context, q string) (AutocompleteResults, error) {
+	q = strings.TrimSpace(q)
+	if len(q) == 0 {
+		return AutocompleteResults{}, c.reject(ctx, autocompleteEndpoint, fmt.Errorf("search query cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["query"] = q
+	params[callEndpoint] = autocompleteEndpoint
+
+	apiResponse := new(autocompleteAPIResponse)
+	err := c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return AutocompleteResults{}, err
+	}
+
+	return apiResponse.toResults(), nil
+}
+
 // GetSongById
 func (c *Client) GetSongById(ctx context.Context, id string) (Song, error) {
 	id = strings.TrimSpace(id)
 	if len(id) == 0 {
-		return Song{}, fmt.Errorf("song id cannot be empty")
+		return Song{}, c.reject(ctx, getSongById, fmt.Errorf("song id cannot be empty: %w", ErrInvalidArgument))
 	}
 
 	params := make(map[string]string)
@@ -89,16 +188,199 @@ func (c *Client) GetSongById(ctx context.Context, id string) (Song, error) {
 	return apiResponse.toSong()
 }
 
+// GetSongRecommendations
+func (c *Client) GetSongRecommendations(ctx context.Context, songID string, opts ...SearchOption) ([]Song, error) {
+	songID = strings.TrimSpace(songID)
+	if len(songID) == 0 {
+		return nil, c.reject(ctx, getRecommendationsEndpoint, fmt.Errorf("song id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetSongRecommendations", languagesOption|explicitOption)
+	if err != nil {
+		return nil, c.reject(ctx, getRecommendationsEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["pid"] = songID
+	if len(searchOpts.languages) > 0 {
+		params["language"] = strings.Join(searchOpts.languages, ",")
+	}
+	params[callEndpoint] = getRecommendationsEndpoint
+
+	apiResponse := new(getRecommendationsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse.toSongs(searchOpts), nil
+}
+
+// CreateStation
+func (c *Client) CreateStation(ctx context.Context, seed StationSeed) (*Station, error) {
+	ctx = withOperation(ctx, "CreateStation")
+	err := seed.validate()
+	if err != nil {
+		return nil, c.reject(ctx, seed.endpoint, err)
+	}
+
+	params := make(map[string]string)
+	for k, v := range seed.params {
+		params[k] = v
+	}
+	params[callEndpoint] = seed.endpoint
+
+	apiResponse := new(createStationAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Station{
+		ID:     apiResponse.StationID,
+		c:      c,
+		played: make(map[string]struct{}),
+	}, nil
+}
+
+// GetHome
+func (c *Client) GetHome(ctx context.Context, languages ...string) ([]HomeModule, error) {
+	params := make(map[string]string)
+	if len(languages) > 0 {
+		params["language"] = strings.Join(languages, ",")
+	}
+	params[callEndpoint] = getLaunchDataEndpoint
+
+	apiResponse := new(getLaunchDataAPIResponse)
+	err := c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse.toModules(), nil
+}
+
+// GetCharts returns all charts, or a page of them when WithPage or WithLimit is given
+func (c *Client) GetCharts(ctx context.Context, opts ...SearchOption) ([]Playlist, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetCharts", paginationOptions|languagesOption)
+	if err != nil {
+		return nil, c.reject(ctx, getChartsEndpoint, err)
+	}
+
+	err = searchOpts.validatePagination()
+	if err != nil {
+		return nil, c.reject(ctx, getChartsEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	if len(searchOpts.languages) > 0 {
+		params["language"] = strings.Join(searchOpts.languages, ",")
+	}
+	params[callEndpoint] = getChartsEndpoint
+
+	apiResponse := new(getChartsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse.toPlaylists(searchOpts), nil
+}
+
+// GetTopPlaylists
+func (c *Client) GetTopPlaylists(ctx context.Context, opts ...SearchOption) ([]Playlist, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetTopPlaylists", paginationOptions|languagesOption)
+	if err != nil {
+		return nil, c.reject(ctx, getFeaturedPlaylistsEndpoint, err)
+	}
+
+	err = searchOpts.validatePagination()
+	if err != nil {
+		return nil, c.reject(ctx, getFeaturedPlaylistsEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["p"] = strconv.Itoa(searchOpts.page)
+	params["n"] = strconv.Itoa(searchOpts.limit)
+	if len(searchOpts.languages) > 0 {
+		params["languages"] = strings.Join(searchOpts.languages, ",")
+	}
+	params[callEndpoint] = getFeaturedPlaylistsEndpoint
+
+	apiResponse := new(getFeaturedPlaylistsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse.toPlaylists(), nil
+}
+
+// GetNewReleases
+func (c *Client) GetNewReleases(ctx context.Context, opts ...SearchOption) (NewReleasesResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetNewReleases", paginationOptions|languagesOption)
+	if err != nil {
+		return NewReleasesResults{}, c.reject(ctx, getNewReleasesEndpoint, err)
+	}
+
+	return c.getNewReleases(ctx, searchOpts)
+}
+
+// ExpandPlaylist returns the playlist info of a playlist, e.g. of a chart
+func (c *Client) ExpandPlaylist(ctx context.Context, p Playlist) (PlaylistInfo, error) {
+	return c.GetPlaylistById(withOperation(ctx, "ExpandPlaylist"), p.ID)
+}
+
+// GetLyrics
+func (c *Client) GetLyrics(ctx context.Context, songID string) (Lyrics, error) {
+	songID = strings.TrimSpace(songID)
+	if len(songID) == 0 {
+		return Lyrics{}, c.reject(ctx, getLyricsEndpoint, fmt.Errorf("song id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["lyrics_id"] = songID
+	params[callEndpoint] = getLyricsEndpoint
+
+	apiResponse := new(getLyricsAPIResponse)
+	err := c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return Lyrics{}, err
+	}
+
+	return apiResponse.toLyrics(songID)
+}
+
 // GetPlaylistById
 func (c *Client) GetPlaylistById(ctx context.Context, id string) (PlaylistInfo, error) {
 	id = strings.TrimSpace(id)
 	if len(id) == 0 {
-		return PlaylistInfo{}, fmt.Errorf("playlist id cannot be empty")
+		return PlaylistInfo{}, c.reject(ctx, getPlaylistById, fmt.Errorf("playlist id cannot be empty: %w", ErrInvalidArgument))
 	}
 
 	_, err := strconv.Atoi(id)
 	if err != nil {
-		return PlaylistInfo{}, fmt.Errorf("playlist id must be a number")
+		return PlaylistInfo{}, c.reject(ctx, getPlaylistById, fmt.Errorf("playlist id must be a number: %w", ErrInvalidArgument))
 	}
 
 	// TODO: add p(page) and n(limit) pagination
@@ -119,12 +401,12 @@ func (c *Client) GetPlaylistById(ctx context.Context, id string) (PlaylistInfo,
 func (c *Client) GetAlbumById(ctx context.Context, id string) (AlbumInfo, error) {
 	id = strings.TrimSpace(id)
 	if len(id) == 0 {
-		return AlbumInfo{}, fmt.Errorf("album id cannot be empty")
+		return AlbumInfo{}, c.reject(ctx, getAlbumById, fmt.Errorf("album id cannot be empty: %w", ErrInvalidArgument))
 	}
 
 	_, err := strconv.Atoi(id)
 	if err != nil {
-		return AlbumInfo{}, fmt.Errorf("album id must be a number")
+		return AlbumInfo{}, c.reject(ctx, getAlbumById, fmt.Errorf("album id must be a number: %w", ErrInvalidArgument))
 	}
 
 	params := make(map[string]string)
@@ -140,12 +422,246 @@ func (c *Client) GetAlbumById(ctx context.Context, id string) (AlbumInfo, error)
 	return apiResponse.toAlbumInfo()
 }
 
+// GetArtistById
+func (c *Client) GetArtistById(ctx context.Context, id string) (ArtistInfo, error) {
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return ArtistInfo{}, c.reject(ctx, getArtistById, fmt.Errorf("artist id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	_, err := strconv.Atoi(id)
+	if err != nil {
+		return ArtistInfo{}, c.reject(ctx, getArtistById, fmt.Errorf("artist id must be a number: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["artistId"] = id
+	params["n_song"] = strconv.Itoa(artistPageSize)
+	params["n_album"] = strconv.Itoa(artistPageSize)
+	params[callEndpoint] = getArtistById
+
+	apiResponse := new(getArtistAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return ArtistInfo{}, err
+	}
+
+	return apiResponse.toArtistInfo()
+}
+
+// ResolveURL
+func (c *Client) ResolveURL(ctx context.Context, rawURL string) (Resolved, error) {
+	ctx = withOperation(ctx, "ResolveURL")
+	link, err := ParseURL(rawURL)
+	if err != nil {
+		return Resolved{}, c.reject(ctx, resolveURLEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["token"] = link.Token
+	params["type"] = string(link.Type)
+	params[callEndpoint] = resolveURLEndpoint
+
+	resolved := Resolved{Type: link.Type}
+	switch link.Type {
+	case LinkSong:
+		apiResponse := new(getSongAPIResponse)
+		err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+		if err != nil {
+			return Resolved{}, err
+		}
+
+		song, err := apiResponse.toSong()
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Song = &song
+	case LinkAlbum:
+		apiResponse := new(getAlbumAPIResponse)
+		err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+		if err != nil {
+			return Resolved{}, err
+		}
+
+		album, err := apiResponse.toAlbumInfo()
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Album = &album
+	case LinkPlaylist:
+		apiResponse := new(getPlaylistAPIResponse)
+		err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+		if err != nil {
+			return Resolved{}, err
+		}
+
+		playlist, err := apiResponse.toPlaylistInfo()
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Playlist = &playlist
+	case LinkArtist:
+		params["n_song"] = strconv.Itoa(artistPageSize)
+		params["n_album"] = strconv.Itoa(artistPageSize)
+		apiResponse := new(getArtistAPIResponse)
+		err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+		if err != nil {
+			return Resolved{}, err
+		}
+
+		artist, err := apiResponse.toArtistInfo()
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Artist = &artist
+	case LinkShow:
+		apiResponse := new(getShowAPIResponse)
+		err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+		if err != nil {
+			return Resolved{}, err
+		}
+
+		show, err := apiResponse.toShowInfo()
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Show = &show
+	case LinkLabel:
+		label, err := c.getLabel(ctx, link.Token, defaultSearchOpts())
+		if err != nil {
+			return Resolved{}, err
+		}
+		resolved.Label = &label
+	}
+
+	return resolved, nil
+}
+
+// GetShowById
+func (c *Client) GetShowById(ctx context.Context, id string) (ShowInfo, error) {
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return ShowInfo{}, c.reject(ctx, getShowById, fmt.Errorf("show id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["show_id"] = id
+	params[callEndpoint] = getShowById
+
+	apiResponse := new(getShowAPIResponse)
+	err := c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return ShowInfo{}, err
+	}
+
+	return apiResponse.toShowInfo()
+}
+
+// GetShowEpisodes returns the episodes of a season of a show, oldest first
+// unless sorted by SortByLatest. Episodes can't be sorted alphabetically.
+func (c *Client) GetShowEpisodes(ctx context.Context, showID string, season int, opts ...SearchOption) (ShowEpisodesResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetShowEpisodes", paginationOptions|sortOrderOption)
+	if err != nil {
+		return ShowEpisodesResults{}, c.reject(ctx, getShowEpisodesEndpoint, err)
+	}
+
+	return c.getShowEpisodes(ctx, showID, season, searchOpts)
+}
+
+// GetEpisodeById
+func (c *Client) GetEpisodeById(ctx context.Context, id string) (Episode, error) {
+	id = strings.TrimSpace(id)
+	if len(id) == 0 {
+		return Episode{}, c.reject(ctx, getEpisodeById, fmt.Errorf("episode id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["episode_id"] = id
+	params[callEndpoint] = getEpisodeById
+
+	apiResponse := new(getEpisodeAPIResponse)
+	err := c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return Episode{}, err
+	}
+
+	return apiResponse.toEpisode()
+}
+
+// GetLabel returns a label by its token, e.g. Song.LabelToken().
+// The api only looks labels up by the token of their url, not by id,
+// the returned Label.ID matches Song.LabelID.
+func (c *Client) GetLabel(ctx context.Context, token string, opts ...SearchOption) (LabelInfo, error) {
+	ctx = withOperation(ctx, "GetLabel")
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetLabel", paginationOptions|sortOrderOption)
+	if err != nil {
+		return LabelInfo{}, c.reject(ctx, getLabelEndpoint, err)
+	}
+
+	return c.getLabel(ctx, token, searchOpts)
+}
+
+// GetUserPlaylists
+func (c *Client) GetUserPlaylists(ctx context.Context, username string, opts ...SearchOption) (UserPlaylistsResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetUserPlaylists", paginationOptions)
+	if err != nil {
+		return UserPlaylistsResults{}, c.reject(ctx, getUserPlaylistsEndpoint, err)
+	}
+
+	return c.getUserPlaylists(ctx, username, searchOpts)
+}
+
+// GetArtistSongs
+func (c *Client) GetArtistSongs(ctx context.Context, id string, opts ...SearchOption) (ArtistSongsResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetArtistSongs", paginationOptions|sortOrderOption)
+	if err != nil {
+		return ArtistSongsResults{}, c.reject(ctx, getArtistSongsEndpoint, err)
+	}
+
+	return c.getArtistSongs(ctx, id, searchOpts)
+}
+
+// GetArtistAlbums
+func (c *Client) GetArtistAlbums(ctx context.Context, id string, opts ...SearchOption) (ArtistAlbumsResults, error) {
+	searchOpts := defaultSearchOpts()
+	for _, opt := range opts {
+		opt(searchOpts)
+	}
+
+	err := searchOpts.allow("GetArtistAlbums", paginationOptions|sortOrderOption)
+	if err != nil {
+		return ArtistAlbumsResults{}, c.reject(ctx, getArtistAlbumsEndpoint, err)
+	}
+
+	return c.getArtistAlbums(ctx, id, searchOpts)
+}
+
 func (c *Client) searchSongs(ctx context.Context, q string, opts *searchOptions) (SearchSongsResults, error) {
 	opts.query = strings.TrimSpace(q)
 
 	params, err := buildSearchParams(opts)
 	if err != nil {
-		return SearchSongsResults{}, err
+		return SearchSongsResults{}, c.reject(ctx, searchSongsEndpoint, err)
 	}
 	params[callEndpoint] = searchSongsEndpoint
 
@@ -163,7 +679,7 @@ func (c *Client) searchArtists(ctx context.Context, q string, opts *searchOption
 
 	params, err := buildSearchParams(opts)
 	if err != nil {
-		return SearchArtistsResults{}, err
+		return SearchArtistsResults{}, c.reject(ctx, searchArtistsEndpoint, err)
 	}
 	params[callEndpoint] = searchArtistsEndpoint
 
@@ -180,7 +696,7 @@ func (c *Client) searchPlaylists(ctx context.Context, q string, opts *searchOpti
 	opts.query = strings.TrimSpace(q)
 	params, err := buildSearchParams(opts)
 	if err != nil {
-		return SearchPlaylistsResults{}, err
+		return SearchPlaylistsResults{}, c.reject(ctx, searchPlaylistsEndpoint, err)
 	}
 	params[callEndpoint] = searchPlaylistsEndpoint
 
@@ -197,7 +713,7 @@ func (c *Client) searchAlbums(ctx context.Context, q string, opts *searchOptions
 	opts.query = strings.TrimSpace(q)
 	params, err := buildSearchParams(opts)
 	if err != nil {
-		return SearchAlbumsResults{}, err
+		return SearchAlbumsResults{}, c.reject(ctx, searchAlbumsEndpoint, err)
 	}
 	params[callEndpoint] = searchAlbumsEndpoint
 
@@ -210,42 +726,321 @@ func (c *Client) searchAlbums(ctx context.Context, q string, opts *searchOptions
 	return apiResponse.toResults(c, opts)
 }
 
-func makeRequest(ctx context.Context, params map[string]string) (*http.Request, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL, nil)
+func (c *Client) searchPodcasts(ctx context.Context, q string, opts *searchOptions) (SearchPodcastsResults, error) {
+	opts.query = strings.TrimSpace(q)
+	params, err := buildSearchParams(opts)
+	if err != nil {
+		return SearchPodcastsResults{}, c.reject(ctx, searchPodcastsEndpoint, err)
+	}
+	params[callEndpoint] = searchPodcastsEndpoint
+
+	apiResponse := new(searchPodcastsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return SearchPodcastsResults{}, err
+	}
+
+	return apiResponse.toResults(c, opts)
+}
+
+func (c *Client) getShowEpisodes(ctx context.Context, showID string, season int, opts *searchOptions) (ShowEpisodesResults, error) {
+	showID = strings.TrimSpace(showID)
+	if len(showID) == 0 {
+		return ShowEpisodesResults{}, c.reject(ctx, getShowEpisodesEndpoint, fmt.Errorf("show id cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	if season < 1 {
+		return ShowEpisodesResults{}, c.reject(ctx, getShowEpisodesEndpoint, fmt.Errorf("season must be greater than 0: %w", ErrInvalidArgument))
+	}
+
+	err := opts.validatePagination()
+	if err != nil {
+		return ShowEpisodesResults{}, c.reject(ctx, getShowEpisodesEndpoint, err)
+	}
+
+	// episodes are only sorted by release date
+	if opts.sortOrder != SortByPopularity && opts.sortOrder != SortByLatest {
+		err = fmt.Errorf("episodes cannot be sorted by %s: %w", opts.sortOrder, ErrInvalidArgument)
+		return ShowEpisodesResults{}, c.reject(ctx, getShowEpisodesEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["show_id"] = showID
+	params["season_number"] = strconv.Itoa(season)
+	params["p"] = strconv.Itoa(opts.page)
+	params["n"] = strconv.Itoa(opts.limit)
+	params["sort_order"] = "asc"
+	if opts.sortOrder == SortByLatest {
+		params["sort_order"] = "desc"
+	}
+	params[callEndpoint] = getShowEpisodesEndpoint
+
+	apiResponse := new(showEpisodesAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return ShowEpisodesResults{}, err
+	}
+
+	return apiResponse.toResults(c, showID, season, opts)
+}
+
+func (c *Client) getLabel(ctx context.Context, token string, opts *searchOptions) (LabelInfo, error) {
+	token = strings.TrimSpace(token)
+	if len(token) == 0 {
+		return LabelInfo{}, c.reject(ctx, getLabelEndpoint, fmt.Errorf("label token cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	err := opts.validatePagination()
+	if err != nil {
+		return LabelInfo{}, c.reject(ctx, getLabelEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["token"] = token
+	params["type"] = string(LinkLabel)
+	params["p"] = strconv.Itoa(opts.page - 1) // label listings are zero indexed
+	params["n_song"] = strconv.Itoa(opts.limit)
+	params["n_album"] = strconv.Itoa(opts.limit)
+	setSortParams(params, opts)
+	params[callEndpoint] = getLabelEndpoint
+
+	apiResponse := new(getLabelAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return LabelInfo{}, err
+	}
+
+	return apiResponse.toLabelInfo(c, token, opts)
+}
+
+func (c *Client) getNewReleases(ctx context.Context, opts *searchOptions) (NewReleasesResults, error) {
+	err := opts.validatePagination()
+	if err != nil {
+		return NewReleasesResults{}, c.reject(ctx, getNewReleasesEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["p"] = strconv.Itoa(opts.page)
+	params["n"] = strconv.Itoa(opts.limit)
+	if len(opts.languages) > 0 {
+		params["languages"] = strings.Join(opts.languages, ",")
+	}
+	params[callEndpoint] = getNewReleasesEndpoint
+
+	apiResponse := new(getAlbumsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return NewReleasesResults{}, err
+	}
+
+	return apiResponse.toResults(c, opts)
+}
+
+func (c *Client) getUserPlaylists(ctx context.Context, username string, opts *searchOptions) (UserPlaylistsResults, error) {
+	username = strings.TrimSpace(username)
+	if len(username) == 0 {
+		return UserPlaylistsResults{}, c.reject(ctx, getUserPlaylistsEndpoint, fmt.Errorf("username cannot be empty: %w", ErrInvalidArgument))
+	}
+
+	err := opts.validatePagination()
+	if err != nil {
+		return UserPlaylistsResults{}, c.reject(ctx, getUserPlaylistsEndpoint, err)
+	}
+
+	params := make(map[string]string)
+	params["username"] = username
+	params["p"] = strconv.Itoa(opts.page)
+	params["n"] = strconv.Itoa(opts.limit)
+	params[callEndpoint] = getUserPlaylistsEndpoint
+
+	apiResponse := new(userPlaylistsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return UserPlaylistsResults{}, err
+	}
+
+	return apiResponse.toResults(c, username, opts)
+}
+
+func (c *Client) makeRequest(ctx context.Context, call *Call) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	q := req.URL.Query()
+	req.URL.RawQuery = c.query(call.Params).Encode()
+
+	req.Header.Set("Content-Type", "application/json")
+	for _, header := range []http.Header{c.header, call.Header} {
+		for k, values := range header {
+			req.Header[k] = append([]string(nil), values...)
+		}
+	}
+
+	for _, cookie := range c.cookies {
+		req.AddCookie(cookie)
+	}
+
+	return req, nil
+}
+
+// query returns the query parameters sent for params
+func (c *Client) query(params map[string]string) url.Values {
+	q := make(url.Values)
 	q.Set("_format", "json")
 	q.Set("_marker", "0")
-	q.Set("api_version", "4")
-	q.Set("ctx", "web6dot0")
+	q.Set("api_version", c.apiVersion)
+	q.Set("ctx", c.apiContext)
 
 	for k, v := range params {
 		q.Set(k, v)
 	}
 
-	req.URL.RawQuery = q.Encode()
+	return q
+}
 
-	req.Header.Set("Content-Type", "application/json")
+func (c *Client) makeRequestAndUnmarshal(ctx context.Context, params map[string]string, v any) error {
+	endpoint := params[callEndpoint]
+	call := &Call{
+		Operation: operationOf(ctx, params),
+		Endpoint:  endpoint,
+		Params:    params,
+		Header:    make(http.Header),
+		Result:    v,
+	}
 
-	return req, nil
+	return c.handler(ctx, call)
 }
 
-func (c *Client) makeRequestAndUnmarshal(ctx context.Context, params map[string]string, v any) error {
-	req, err := makeRequest(ctx, params)
-	if err != nil {
+// reject passes err through the middleware chain as the result of a call to
+// endpoint that is never sent, so middlewares see invalid arguments too
+func (c *Client) reject(ctx context.Context, endpoint string, err error) error {
+	params := map[string]string{callEndpoint: endpoint}
+	call := &Call{
+		Operation: operationOf(ctx, params),
+		Endpoint:  endpoint,
+		Params:    params,
+		Header:    make(http.Header),
+		err:       err,
+	}
+
+	return c.handler(ctx, call)
+}
+
+// execute is the innermost handler of the middleware chain
+func (c *Client) execute(ctx context.Context, call *Call) error {
+	if call.err != nil {
+		return call.err
+	}
+
+	start := time.Now()
+	defer func() {
+		call.Latency = time.Since(start)
+	}()
+
+	var err error
+	if c.cache == nil {
+		_, err = c.fetch(ctx, call)
+	} else {
+		err = c.cached(ctx, call)
+	}
+
+	if r, ok := call.Result.(validator); ok && err == nil {
+		err = r.validate()
+	}
+
+	if r, ok := call.Result.(counter); ok && err == nil {
+		call.ResultCount = r.count()
+	}
+
+	return err
+}
+
+// fetch requests the call from the api, retrying failures, and returns the raw response body
+func (c *Client) fetch(ctx context.Context, call *Call) ([]byte, error) {
+	var body []byte
+	err := c.retry(ctx, call.Params, func() error {
+		var err error
+		call.Attempts++
+		body, err = c.do(ctx, call)
 		return err
+	})
+
+	return body, err
+}
+
+func (c *Client) do(ctx context.Context, call *Call) ([]byte, error) {
+	start := time.Now()
+	err := c.wait(ctx, call.Params[callEndpoint])
+	call.LimiterWait += time.Since(start)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.makeRequest(ctx, call)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(v)
+	body, err := io.ReadAll(resp.Body)
+	call.StatusCode = resp.StatusCode
+	call.BytesRead = len(body)
+	if err != nil {
+		return nil, err
+	}
+
+	err = decodeResponse(resp.StatusCode, call.Params, body, call.Result)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		apiErr.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+func (c *Client) getArtistSongs(ctx context.Context, id string, opts *searchOptions) (ArtistSongsResults, error) {
+	id = strings.TrimSpace(id)
+	params, err := buildArtistParams(id, opts)
+	if err != nil {
+		return ArtistSongsResults{}, c.reject(ctx, getArtistSongsEndpoint, err)
+	}
+	params["n_song"] = strconv.Itoa(opts.limit)
+	params[callEndpoint] = getArtistSongsEndpoint
+
+	apiResponse := new(artistSongsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return ArtistSongsResults{}, err
+	}
+
+	return apiResponse.toResults(c, id, opts)
+}
+
+func (c *Client) getArtistAlbums(ctx context.Context, id string, opts *searchOptions) (ArtistAlbumsResults, error) {
+	id = strings.TrimSpace(id)
+	params, err := buildArtistParams(id, opts)
+	if err != nil {
+		return ArtistAlbumsResults{}, c.reject(ctx, getArtistAlbumsEndpoint, err)
+	}
+	params["n_album"] = strconv.Itoa(opts.limit)
+	params[callEndpoint] = getArtistAlbumsEndpoint
+
+	apiResponse := new(artistAlbumsAPIResponse)
+	err = c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return ArtistAlbumsResults{}, err
+	}
+
+	return apiResponse.toResults(c, id, opts)
 }
 
 func buildSearchParams(opts *searchOptions) (map[string]string, error) {
@@ -261,3 +1056,41 @@ func buildSearchParams(opts *searchOptions) (map[string]string, error) {
 
 	return params, nil
 }
+
+func buildArtistParams(id string, opts *searchOptions) (map[string]string, error) {
+	if len(id) == 0 {
+		return nil, fmt.Errorf("artist id cannot be empty: %w", ErrInvalidArgument)
+	}
+
+	if _, err := strconv.Atoi(id); err != nil {
+		return nil, fmt.Errorf("artist id must be a number: %w", ErrInvalidArgument)
+	}
+
+	err := opts.validatePagination()
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]string)
+	params["artistId"] = id
+	params["page"] = strconv.Itoa(opts.page - 1) // artist listings are zero indexed
+	setSortParams(params, opts)
+
+	return params, nil
+}
+
+// setSortParams sets the category and sort order of artist and label listings
+func setSortParams(params map[string]string, opts *searchOptions) {
+	// popularity is the default category
+	switch opts.sortOrder {
+	case SortByLatest:
+		params["category"] = "latest"
+		params["sort_order"] = "desc"
+	case SortByAlphabetical:
+		params["category"] = "alphabetical"
+		params["sort_order"] = "asc"
+	default:
+		params["category"] = ""
+		params["sort_order"] = "desc"
+	}
+}
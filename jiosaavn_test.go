@@ -1,18 +1,835 @@
 package jiosaavn_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ppalone/jiosaavn"
+	"github.com/ppalone/jiosaavn/jiosaavntest"
+	"github.com/ppalone/jiosaavn/recorder"
 	"github.com/stretchr/testify/assert"
 )
 
+var record = flag.Bool("record", false, "record cassettes against the live JioSaavn API")
+
+// newTestClient returns a client replaying the cassette of the running test,
+// run the tests with -record to record it. Tests without a cassette fail.
+func newTestClient(t *testing.T) *jiosaavn.Client {
+	t.Helper()
+
+	mode := recorder.ModeReplay
+	if *record {
+		mode = recorder.ModeRecord
+	}
+
+	path := filepath.Join("testdata", t.Name()+".json")
+	if _, err := os.Stat(path); !*record && errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("no cassette recorded at %s, run go test . -record", path)
+	}
+
+	r, err := recorder.New(path, mode)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := r.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	return jiosaavn.NewClient(&http.Client{Transport: r})
+}
+
+// listJSON returns a json list of n minimal entities with ids prefixed by prefix
+func listJSON(prefix string, n int) string {
+	items := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		items = append(items, fmt.Sprintf(`{"id":"%s%d","title":"%s %d"}`, prefix, i, prefix, i))
+	}
+
+	return "[" + strings.Join(items, ",") + "]"
+}
+
 func TestNewClient(t *testing.T) {
 	c := jiosaavn.NewClient(nil)
 	assert.NotNil(t, c)
 }
 
+func TestClientOptions(t *testing.T) {
+	t.Run("with default options", func(t *testing.T) {
+		var got *http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "song.getDetails", got.URL.Query().Get("__call"))
+		assert.Equal(t, "1xqHQw3J", got.URL.Query().Get("pids"))
+		assert.Equal(t, "web6dot0", got.URL.Query().Get("ctx"))
+		assert.Equal(t, "4", got.URL.Query().Get("api_version"))
+		assert.Equal(t, "json", got.URL.Query().Get("_format"))
+	})
+
+	t.Run("with custom options", func(t *testing.T) {
+		var got *http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithAPIContext(jiosaavn.APIContextAndroid),
+			jiosaavn.WithAPIVersion("5"),
+			jiosaavn.WithUserAgent("jiosaavn-test"),
+			jiosaavn.WithHeader("X-Request-Source", "test"),
+			jiosaavn.WithCookies(&http.Cookie{Name: "L", Value: "english"}),
+		)
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "android", got.URL.Query().Get("ctx"))
+		assert.Equal(t, "5", got.URL.Query().Get("api_version"))
+		assert.Equal(t, "jiosaavn-test", got.Header.Get("User-Agent"))
+		assert.Equal(t, "test", got.Header.Get("X-Request-Source"))
+
+		cookie, err := got.Cookie("L")
+		assert.NoError(t, err)
+		assert.Equal(t, "english", cookie.Value)
+	})
+}
+
+func TestAPIErrors(t *testing.T) {
+	newServer := func(status int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+	}
+
+	t.Run("with invalid arguments", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetSongById(context.Background(), "")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.GetAlbumById(context.Background(), "abc")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.SearchSongs(context.Background(), "Animals", jiosaavn.WithLimit(50))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with status codes", func(t *testing.T) {
+		tests := []struct {
+			status int
+			err    error
+		}{
+			{http.StatusNotFound, jiosaavn.ErrNotFound},
+			{http.StatusTooManyRequests, jiosaavn.ErrRateLimited},
+			{http.StatusBadRequest, jiosaavn.ErrInvalidArgument},
+			{http.StatusInternalServerError, jiosaavn.ErrUnexpectedResponse},
+		}
+
+		for _, tc := range tests {
+			srv := newServer(tc.status, "upstream failure")
+			c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+			_, err := c.GetAlbumById(context.Background(), "27007462")
+			srv.Close()
+
+			assert.ErrorIs(t, err, tc.err)
+
+			var apiErr *jiosaavn.APIError
+			assert.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tc.status, apiErr.StatusCode)
+			assert.Equal(t, "content.getAlbumDetails", apiErr.Endpoint)
+			assert.Equal(t, "27007462", apiErr.Params["albumid"])
+			assert.Equal(t, "upstream failure", apiErr.Body)
+		}
+	})
+
+	t.Run("with malformed body", func(t *testing.T) {
+		srv := newServer(http.StatusOK, "{")
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.SearchSongs(context.Background(), "Animals")
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+	})
+
+	t.Run("with in-band error payload", func(t *testing.T) {
+		srv := newServer(http.StatusOK, `{"error":{"code":"INPUT_INVALID","msg":"Invalid song id"}}`)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetSongById(context.Background(), "xxxxxxxx")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		var apiErr *jiosaavn.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusOK, apiErr.StatusCode)
+		assert.Equal(t, "INPUT_INVALID", apiErr.Code)
+		assert.Equal(t, "Invalid song id", apiErr.Message)
+	})
+
+	t.Run("with empty array in place of object", func(t *testing.T) {
+		srv := newServer(http.StatusOK, `[]`)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetAlbumById(context.Background(), "99999999999999")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+	})
+
+	t.Run("with html maintenance page", func(t *testing.T) {
+		srv := newServer(http.StatusOK, "<html><body>Under maintenance</body></html>")
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetPlaylistById(context.Background(), "1141249906")
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+
+		var apiErr *jiosaavn.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Contains(t, apiErr.Body, "Under maintenance")
+	})
+
+	t.Run("with missing entity", func(t *testing.T) {
+		srv := newServer(http.StatusOK, `{"songs":[]}`)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetSongById(context.Background(), "xxxxxxxx")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+		assert.ErrorContains(t, err, "invalid song id")
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+	policy := jiosaavn.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     5 * time.Millisecond,
+	}
+
+	t.Run("with transient failures", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+		defer srv.Close()
+
+		var events []jiosaavn.RetryEvent
+		p := policy
+		p.OnRetry = func(e jiosaavn.RetryEvent) {
+			events = append(events, e)
+		}
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRetryPolicy(p))
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "Faded", song.Title)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Len(t, events, 2)
+		assert.Equal(t, "song.getDetails", events[0].Endpoint)
+		assert.Equal(t, 1, events[0].Attempt)
+		assert.ErrorIs(t, events[0].Err, jiosaavn.ErrUnexpectedResponse)
+	})
+
+	t.Run("with exhausted attempts", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRetryPolicy(policy))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("with non retryable error", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRetryPolicy(policy))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("with closed connections", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				conn.Close()
+				return
+			}
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRetryPolicy(policy))
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "Faded", song.Title)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("with invalid base url", func(t *testing.T) {
+		var retries int
+		p := policy
+		p.OnRetry = func(e jiosaavn.RetryEvent) {
+			retries++
+		}
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL("http://[::1"), jiosaavn.WithRetryPolicy(p))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.Error(t, err)
+		assert.Zero(t, retries)
+	})
+
+	t.Run("with retry after and cancelled context", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		var delay time.Duration
+		p := policy
+		p.OnRetry = func(e jiosaavn.RetryEvent) {
+			delay = e.Delay
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRetryPolicy(p))
+		_, err := c.GetSongById(ctx, "1xqHQw3J")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, 120*time.Second, delay)
+	})
+
+	t.Run("without retry policy", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+type countingLimiter struct {
+	calls atomic.Int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.calls.Add(1)
+	return nil
+}
+
+type failingLimiter struct {
+	calls atomic.Int32
+	err   error
+}
+
+func (l *failingLimiter) Wait(ctx context.Context) error {
+	l.calls.Add(1)
+	return l.err
+}
+
+func TestRateLimiter(t *testing.T) {
+	newServer := func() *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+	}
+
+	t.Run("with token bucket", func(t *testing.T) {
+		b := jiosaavn.NewTokenBucket(100, 2)
+
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, b.Wait(context.Background()))
+		}
+		assert.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+	})
+
+	t.Run("with cancelled context", func(t *testing.T) {
+		b := jiosaavn.NewTokenBucket(0.1, 1)
+		assert.NoError(t, b.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, b.Wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("with global and endpoint limiters", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		global, search := new(countingLimiter), new(countingLimiter)
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithRateLimiter(global),
+			jiosaavn.WithEndpointRateLimiter("search.getResults", search),
+		)
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		_, err = c.SearchSongs(context.Background(), "Faded")
+		assert.NoError(t, err)
+
+		assert.Equal(t, int32(2), global.calls.Load())
+		assert.Equal(t, int32(1), search.calls.Load())
+	})
+
+	t.Run("with limiter blocking past deadline", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		b := jiosaavn.NewTokenBucket(0.1, 1)
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithRateLimiter(b))
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = c.GetSongById(ctx, "1xqHQw3J")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("with limiter error", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		quotaErr := errors.New("quota exceeded")
+		limiter := &failingLimiter{err: quotaErr}
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithRateLimiter(limiter),
+			jiosaavn.WithRetryPolicy(jiosaavn.DefaultRetryPolicy()),
+		)
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, quotaErr)
+		assert.Equal(t, int32(1), limiter.calls.Load())
+	})
+}
+
+func TestCache(t *testing.T) {
+	newServer := func(calls *atomic.Int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+	}
+
+	t.Run("with lru cache", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithCache(jiosaavn.NewLRUCache(10)))
+		for i := 0; i < 3; i++ {
+			song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+			assert.NoError(t, err)
+			assert.Equal(t, "Faded", song.Title)
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("with bypassed cache", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithCache(jiosaavn.NewLRUCache(10)))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		_, err = c.GetSongById(jiosaavn.BypassCache(context.Background()), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("with disabled endpoint", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithCache(jiosaavn.NewLRUCache(10)),
+			jiosaavn.WithCacheTTL("song.getDetails", 0),
+		)
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		_, err = c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("with expired entry", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithCache(jiosaavn.NewLRUCache(10)),
+			jiosaavn.WithCacheTTL("song.getDetails", time.Millisecond),
+		)
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+		_, err = c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("with stale while revalidate", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(
+			nil,
+			jiosaavn.WithBaseURL(srv.URL),
+			jiosaavn.WithCache(jiosaavn.NewLRUCache(10)),
+			jiosaavn.WithCacheTTL("song.getDetails", time.Millisecond),
+			jiosaavn.WithStaleWhileRevalidate(time.Minute),
+		)
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		time.Sleep(5 * time.Millisecond)
+
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "Faded", song.Title)
+		assert.Eventually(t, func() bool {
+			return calls.Load() == 2
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("with file cache", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		cache, err := jiosaavn.NewFileCache(t.TempDir())
+		assert.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithCache(cache))
+			song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+			assert.NoError(t, err)
+			assert.Equal(t, "Faded", song.Title)
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("with different cookies", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := newServer(&calls)
+		defer srv.Close()
+
+		cache := jiosaavn.NewLRUCache(10)
+		for _, languages := range []string{"english", "hindi", "english"} {
+			c := jiosaavn.NewClient(
+				nil,
+				jiosaavn.WithBaseURL(srv.URL),
+				jiosaavn.WithCache(cache),
+				jiosaavn.WithCookies(&http.Cookie{Name: "L", Value: languages}),
+			)
+			_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+			assert.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("with lru eviction", func(t *testing.T) {
+		cache := jiosaavn.NewLRUCache(2)
+		entry := jiosaavn.CacheEntry{Body: []byte("{}"), ExpiresAt: time.Now().Add(time.Minute)}
+		cache.Set(context.Background(), "a", entry)
+		cache.Set(context.Background(), "b", entry)
+		_, ok := cache.Get(context.Background(), "a")
+		assert.True(t, ok)
+
+		cache.Set(context.Background(), "c", entry)
+		assert.Equal(t, 2, cache.Len())
+		_, ok = cache.Get(context.Background(), "b")
+		assert.False(t, ok)
+		_, ok = cache.Get(context.Background(), "a")
+		assert.True(t, ok)
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	newServer := func() *jiosaavntest.Server {
+		srv := jiosaavntest.NewServer()
+		srv.AddSongs(jiosaavn.Song{ID: "1xqHQw3J", Title: "Faded"})
+		return srv
+	}
+
+	t.Run("with chain order", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		var order []string
+		trace := func(name string) jiosaavn.Middleware {
+			return func(next jiosaavn.Handler) jiosaavn.Handler {
+				return func(ctx context.Context, call *jiosaavn.Call) error {
+					order = append(order, name+" before")
+					err := next(ctx, call)
+					order = append(order, name+" after")
+					return err
+				}
+			}
+		}
+
+		c := srv.Client(jiosaavn.WithMiddleware(trace("outer"), trace("inner")))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"outer before", "inner before", "inner after", "outer after"}, order)
+	})
+
+	t.Run("with observed call", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		var observed jiosaavn.Call
+		var observedErr error
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				observedErr = next(ctx, call)
+				observed = *call
+				return observedErr
+			}
+		}
+
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+		_, err := c.SearchSongs(context.Background(), "faded")
+		assert.NoError(t, err)
+		assert.NoError(t, observedErr)
+		assert.Equal(t, "SearchSongs", observed.Operation)
+		assert.Equal(t, "search.getResults", observed.Endpoint)
+		assert.Equal(t, "faded", observed.Params["q"])
+		assert.NotNil(t, observed.Result)
+		assert.Greater(t, observed.Latency, time.Duration(0))
+	})
+
+	t.Run("with unknown id", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		var observedErr error
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				observedErr = next(ctx, call)
+				return observedErr
+			}
+		}
+
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+		_, err := c.GetSongById(context.Background(), "unknown")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+		assert.ErrorIs(t, observedErr, jiosaavn.ErrNotFound)
+	})
+
+	t.Run("with invalid argument", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		var observed jiosaavn.Call
+		var observedErr error
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				observedErr = next(ctx, call)
+				observed = *call
+				return observedErr
+			}
+		}
+
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+		_, err := c.SearchSongs(context.Background(), " ")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.ErrorIs(t, observedErr, jiosaavn.ErrInvalidArgument)
+		assert.Equal(t, "SearchSongs", observed.Operation)
+		assert.Equal(t, "search.getResults", observed.Endpoint)
+		assert.Empty(t, srv.Requests())
+	})
+
+	t.Run("with modified request", func(t *testing.T) {
+		var got *http.Request
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+		}))
+		defer srv.Close()
+
+		inject := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				call.Header.Set("X-Audit-Id", "42")
+				call.Params["includeMetaTags"] = "0"
+				return next(ctx, call)
+			}
+		}
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL), jiosaavn.WithMiddleware(inject))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "42", got.Header.Get("X-Audit-Id"))
+		assert.Equal(t, "0", got.URL.Query().Get("includeMetaTags"))
+	})
+
+	t.Run("with fault injection", func(t *testing.T) {
+		srv := newServer()
+		defer srv.Close()
+
+		fault := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				return jiosaavn.ErrRateLimited
+			}
+		}
+
+		c := srv.Client(jiosaavn.WithMiddleware(fault))
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrRateLimited)
+		assert.Empty(t, srv.Requests())
+	})
+}
+
+func TestLogger(t *testing.T) {
+	logs := func(buf *bytes.Buffer) []map[string]any {
+		entries := make([]map[string]any, 0)
+		dec := json.NewDecoder(buf)
+		for dec.More() {
+			entry := make(map[string]any)
+			if err := dec.Decode(&entry); err != nil {
+				t.Fatal(err)
+			}
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	t.Run("with successful call", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(jiosaavn.Song{ID: "1xqHQw3J", Title: "Faded"})
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+		c := srv.Client(jiosaavn.WithLogger(logger))
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+
+		entries := logs(buf)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "INFO", entries[0]["level"])
+		assert.Equal(t, "song.getDetails", entries[0]["endpoint"])
+		assert.Equal(t, "GetSongById", entries[0]["operation"])
+		assert.Equal(t, float64(http.StatusOK), entries[0]["status"])
+		assert.Greater(t, entries[0]["bytes"], float64(0))
+		assert.Equal(t, map[string]any{"pids": "1xqHQw3J"}, entries[0]["params"])
+	})
+
+	t.Run("with undecodable response", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("song.getDetails", []byte(`{"songs":"broken"}`))
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		c := srv.Client(jiosaavn.WithLogger(logger))
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+
+		entries := logs(buf)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "ERROR", entries[0]["level"])
+		assert.Contains(t, entries[0]["error"], "unexpected response")
+		assert.Equal(t, "DEBUG", entries[1]["level"])
+		assert.Contains(t, entries[1]["body"], `"songs":"broken"`)
+	})
+
+	t.Run("with unknown id", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+		c := srv.Client(jiosaavn.WithLogger(logger))
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+
+		entries := logs(buf)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "ERROR", entries[0]["level"])
+		assert.Equal(t, float64(http.StatusOK), entries[0]["status"])
+		assert.Contains(t, entries[0]["error"], "invalid song id")
+	})
+
+	t.Run("with invalid argument", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+		c := jiosaavn.NewClient(nil, jiosaavn.WithLogger(logger))
+
+		_, err := c.GetSongById(context.Background(), " ")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		entries := logs(buf)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "ERROR", entries[0]["level"])
+		assert.Equal(t, "GetSongById", entries[0]["operation"])
+		assert.Contains(t, entries[0]["error"], "song id cannot be empty")
+	})
+}
+
 func TestSearchSongs(t *testing.T) {
 	t.Run("with empty search query", func(t *testing.T) {
 		c := jiosaavn.NewClient(nil)
@@ -21,7 +838,7 @@ func TestSearchSongs(t *testing.T) {
 	})
 
 	t.Run("with no search options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchSongs(context.Background(), "Animals")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -31,7 +848,7 @@ func TestSearchSongs(t *testing.T) {
 	})
 
 	t.Run("with valid limit search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		limit := 40
 		res, err := c.SearchSongs(context.Background(), "Animals", jiosaavn.WithLimit(limit))
 		assert.NoError(t, err)
@@ -49,8 +866,15 @@ func TestSearchSongs(t *testing.T) {
 		assert.ErrorContains(t, err, "limit must be between 10 and 40")
 	})
 
-	t.Run("with page search option", func(t *testing.T) {
+	t.Run("with options that don't apply", func(t *testing.T) {
 		c := jiosaavn.NewClient(nil)
+		_, err := c.SearchSongs(context.Background(), "Animals", jiosaavn.WithSortOrder(jiosaavn.SortByLatest))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "WithSortOrder doesn't apply to SearchSongs")
+	})
+
+	t.Run("with page search option", func(t *testing.T) {
+		c := newTestClient(t)
 		res1, err := c.SearchSongs(context.Background(), "Animals")
 		assert.NoError(t, err)
 
@@ -64,7 +888,7 @@ func TestSearchSongs(t *testing.T) {
 	})
 
 	t.Run("with page and limit options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		limit, page := 30, 2
 		opts := []jiosaavn.SearchOption{
 			jiosaavn.WithLimit(limit),
@@ -78,7 +902,7 @@ func TestSearchSongs(t *testing.T) {
 	})
 
 	t.Run("with next results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchSongs(context.Background(), "Animals")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -94,7 +918,7 @@ func TestSearchSongs(t *testing.T) {
 
 	t.Run("with next and page results", func(t *testing.T) {
 		t.Skip("tests pass on local and fail on workflow for some reason")
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchSongs(context.Background(), "Animals")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -112,7 +936,7 @@ func TestSearchSongs(t *testing.T) {
 	})
 
 	t.Run("with no search results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchSongs(context.Background(), "qazwsxecrfvtgbyhnujmik")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -123,7 +947,7 @@ func TestSearchSongs(t *testing.T) {
 
 func TestSearchArtists(t *testing.T) {
 	t.Run("with no search options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchArtists(context.Background(), "Alan Walker")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -131,7 +955,7 @@ func TestSearchArtists(t *testing.T) {
 	})
 
 	t.Run("with limit search options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		limit := 30
 		res, err := c.SearchArtists(context.Background(), "Alan Walker", jiosaavn.WithLimit(limit))
 		assert.NoError(t, err)
@@ -142,7 +966,7 @@ func TestSearchArtists(t *testing.T) {
 	})
 
 	t.Run("with page search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		page := 3
 		res, err := c.SearchArtists(context.Background(), "Alan Walker", jiosaavn.WithPage(page))
 		assert.NoError(t, err)
@@ -151,7 +975,7 @@ func TestSearchArtists(t *testing.T) {
 	})
 
 	t.Run("with next results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchArtists(context.Background(), "Alan Walker")
 		assert.NoError(t, err)
 		assert.Equal(t, 1, res.Page)
@@ -167,7 +991,7 @@ func TestSearchArtists(t *testing.T) {
 	})
 
 	t.Run("with page and next results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		q := "Alan Walker"
 		res, err := c.SearchArtists(context.Background(), q)
 		assert.NoError(t, err)
@@ -187,7 +1011,7 @@ func TestSearchArtists(t *testing.T) {
 
 func TestSearchPlaylists(t *testing.T) {
 	t.Run("with no search options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchPlaylists(context.Background(), "EDM")
 		assert.NoError(t, err)
 		assert.NotEmpty(t, res.Playlists)
@@ -195,7 +1019,7 @@ func TestSearchPlaylists(t *testing.T) {
 	})
 
 	t.Run("with page search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		p := 2
 		res, err := c.SearchPlaylists(context.Background(), "EDM", jiosaavn.WithPage(p))
 		assert.NoError(t, err)
@@ -205,7 +1029,7 @@ func TestSearchPlaylists(t *testing.T) {
 	})
 
 	t.Run("with limit search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		limit := 25
 		res, err := c.SearchPlaylists(context.Background(), "EDM", jiosaavn.WithLimit(limit))
 		assert.NoError(t, err)
@@ -216,7 +1040,7 @@ func TestSearchPlaylists(t *testing.T) {
 	})
 
 	t.Run("with next and page results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		q := "EDM"
 		res, err := c.SearchPlaylists(context.Background(), q)
 		assert.NoError(t, err)
@@ -243,7 +1067,7 @@ func TestSearchAlbums(t *testing.T) {
 	t.Skip("tests failing on workflow for some reason")
 
 	t.Run("with no search options", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.SearchAlbums(context.Background(), "avicii")
 		assert.NoError(t, err)
 		assert.NotEmpty(t, res.Albums)
@@ -252,7 +1076,7 @@ func TestSearchAlbums(t *testing.T) {
 	})
 
 	t.Run("with limit search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		limit := 35
 		res, err := c.SearchAlbums(context.Background(), "avicii", jiosaavn.WithLimit(limit))
 		assert.NoError(t, err)
@@ -262,7 +1086,7 @@ func TestSearchAlbums(t *testing.T) {
 	})
 
 	t.Run("with page search option", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		p := 2
 		res, err := c.SearchAlbums(context.Background(), "avicii", jiosaavn.WithPage(p))
 		assert.NoError(t, err)
@@ -272,7 +1096,7 @@ func TestSearchAlbums(t *testing.T) {
 	})
 
 	t.Run("with next and page results", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		q := "avicii"
 		res, err := c.SearchAlbums(context.Background(), q)
 		assert.NoError(t, err)
@@ -302,20 +1126,41 @@ func TestGetSongById(t *testing.T) {
 	})
 
 	t.Run("with invalid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		_, err := c.GetSongById(context.Background(), "xxxxxxxx")
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "invalid song id")
 	})
 
 	t.Run("with valid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		id := "1xqHQw3J" // Faded by Alan Walker
 		song, err := c.GetSongById(context.Background(), id)
 		assert.NoError(t, err)
 		assert.NotNil(t, song)
 		assert.Equal(t, "Faded", song.Title)
 	})
+
+	t.Run("with malformed media url", func(t *testing.T) {
+		var encrypted string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded","more_info":{"encrypted_media_url":"` + encrypted + `"}}]}`))
+		}))
+		defer srv.Close()
+
+		c := jiosaavn.NewClient(nil, jiosaavn.WithBaseURL(srv.URL))
+		for _, encrypted = range []string{
+			"",             // empty
+			"not base64!",  // undecodable
+			"AAAAAAA=",     // not a multiple of the block size
+			"AAAAAAAAAAA=", // invalid padding
+		} {
+			song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+			assert.NoError(t, err, encrypted)
+			assert.Equal(t, "Faded", song.Title, encrypted)
+			assert.Empty(t, song.MediaURL, encrypted)
+		}
+	})
 }
 
 func TestGetPlaylistById(t *testing.T) {
@@ -327,7 +1172,7 @@ func TestGetPlaylistById(t *testing.T) {
 	})
 
 	t.Run("with valid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		id := "1141249906"
 		res, err := c.GetPlaylistById(context.Background(), id)
 		assert.NoError(t, err)
@@ -338,7 +1183,7 @@ func TestGetPlaylistById(t *testing.T) {
 	})
 
 	t.Run("with invalid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		id := "99999999999999"
 		_, err := c.GetPlaylistById(context.Background(), id)
 		assert.Error(t, err)
@@ -354,7 +1199,7 @@ func TestGetAlbumById(t *testing.T) {
 	})
 
 	t.Run("with valid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		res, err := c.GetAlbumById(context.Background(), "27007462")
 		assert.NoError(t, err)
 		assert.Equal(t, "Live A Life You Will Remember", res.Title)
@@ -363,10 +1208,1055 @@ func TestGetAlbumById(t *testing.T) {
 	})
 
 	t.Run("with invalid id", func(t *testing.T) {
-		c := jiosaavn.NewClient(nil)
+		c := newTestClient(t)
 		id := "99999999999999"
 		_, err := c.GetAlbumById(context.Background(), id)
 		assert.Error(t, err)
 		assert.ErrorContains(t, err, "invalid album id")
 	})
 }
+
+func TestGetArtistById(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetArtistById(context.Background(), "")
+		assert.ErrorContains(t, err, "artist id cannot be empty")
+	})
+
+	t.Run("with non numeric id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetArtistById(context.Background(), "arijit-singh")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.GetArtistById(context.Background(), "459320") // Arijit Singh
+		assert.NoError(t, err)
+		assert.Equal(t, "459320", res.ID)
+		assert.Equal(t, "Arijit Singh", res.Name)
+		assert.NotEmpty(t, res.PermanentURL)
+		assert.Positive(t, res.FollowerCount)
+		assert.NotEmpty(t, res.Bio)
+		assert.NotEmpty(t, res.TopSongs)
+		assert.NotEmpty(t, res.TopAlbums)
+		assert.NotEmpty(t, res.SimilarArtists)
+	})
+
+	t.Run("with invalid id", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("artist.getArtistPageDetails", []byte(`{"artistId":"","name":""}`))
+
+		_, err := srv.Client().GetArtistById(context.Background(), "99999999999999")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+		assert.ErrorContains(t, err, "invalid artist id")
+	})
+}
+
+func TestGetArtistSongs(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetArtistSongs(context.Background(), "")
+		assert.ErrorContains(t, err, "artist id cannot be empty")
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetArtistSongs(context.Background(), "459320", jiosaavn.WithPage(0))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.GetArtistSongs(context.Background(), "459320", jiosaavn.WithSortOrder("random"))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.GetArtistSongs(context.Background(), "459320")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Page)
+		assert.Equal(t, 10, res.Size)
+		assert.True(t, res.HasNext)
+
+		next, err := res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, next.Page)
+		assert.NotEqual(t, res.Songs, next.Songs)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("artist.getArtistMoreSong", []byte(`{"topSongs":{"songs":`+listJSON("song", 10)+`,"total":12,"last_page":false}}`))
+
+		res, err := srv.Client().GetArtistSongs(context.Background(), "459320", jiosaavn.WithSortOrder(jiosaavn.SortByLatest))
+		assert.NoError(t, err)
+		assert.Equal(t, 12, res.Total)
+		assert.True(t, res.HasNext)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "459320", params.Get("artistId"))
+		assert.Equal(t, "0", params.Get("page"))
+		assert.Equal(t, "10", params.Get("n_song"))
+		assert.Equal(t, "latest", params.Get("category"))
+		assert.Equal(t, "desc", params.Get("sort_order"))
+
+		srv.HandleJSON("artist.getArtistMoreSong", []byte(`{"topSongs":{"songs":`+listJSON("song", 2)+`,"total":12,"last_page":true}}`))
+		next, err := res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, next.Page)
+		assert.False(t, next.HasNext)
+		assert.Equal(t, "1", srv.Requests()[1].Get("page"))
+
+		_, err = next.Next(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("with empty page", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("artist.getArtistMoreSong", []byte(`{"topSongs":{"songs":[],"total":120,"last_page":false}}`))
+		srv.HandleJSON("artist.getArtistMoreAlbum", []byte(`{"topAlbums":{"albums":[],"total":40,"last_page":false}}`))
+		c := srv.Client()
+
+		songs, err := c.GetArtistSongs(context.Background(), "459320", jiosaavn.WithPage(13))
+		assert.NoError(t, err)
+		assert.Empty(t, songs.Songs)
+		assert.False(t, songs.HasNext)
+
+		albums, err := c.GetArtistAlbums(context.Background(), "459320", jiosaavn.WithPage(5))
+		assert.NoError(t, err)
+		assert.Empty(t, albums.Albums)
+		assert.False(t, albums.HasNext)
+	})
+}
+
+func TestGetArtistAlbums(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetArtistAlbums(context.Background(), "")
+		assert.ErrorContains(t, err, "artist id cannot be empty")
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.GetArtistAlbums(context.Background(), "459320", jiosaavn.WithSortOrder(jiosaavn.SortByLatest))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.Albums)
+		assert.Positive(t, res.Total)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("artist.getArtistMoreAlbum", []byte(`{"topAlbums":{"albums":`+listJSON("album", 3)+`,"total":3,"last_page":true}}`))
+
+		res, err := srv.Client().GetArtistAlbums(context.Background(), "459320", jiosaavn.WithSortOrder(jiosaavn.SortByAlphabetical), jiosaavn.WithLimit(20))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, res.Size)
+		assert.False(t, res.HasNext)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "20", params.Get("n_album"))
+		assert.Equal(t, "alphabetical", params.Get("category"))
+		assert.Equal(t, "asc", params.Get("sort_order"))
+	})
+}
+
+func TestGetLyrics(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetLyrics(context.Background(), " ")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J") // Faded by Alan Walker
+		assert.NoError(t, err)
+		if !song.HasLyrics {
+			t.Skip("song has no lyrics")
+		}
+
+		lyrics, err := c.GetLyrics(context.Background(), song.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, song.ID, lyrics.SongID)
+		assert.NotEmpty(t, lyrics.Text)
+		assert.NotContains(t, lyrics.Text, "<br>")
+		assert.NotEmpty(t, lyrics.Writers)
+	})
+
+	t.Run("with html", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("lyrics.getLyrics", []byte(`{"lyrics":"Line one<br>Line &amp; two<BR/><br />Line three","lyrics_copyright":"Writer(s): Jane Doe, John Doe<br>Lyrics powered by www.musixmatch.com","snippet":"Line one"}`))
+
+		lyrics, err := srv.Client().GetLyrics(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "Line one\nLine & two\n\nLine three", lyrics.Text)
+		assert.Equal(t, []string{"Jane Doe", "John Doe"}, lyrics.Writers)
+		assert.Contains(t, lyrics.Copyright, "\nLyrics powered by")
+		assert.Equal(t, "1xqHQw3J", srv.Requests()[0].Get("lyrics_id"))
+	})
+
+	t.Run("without lyrics", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("lyrics.getLyrics", []byte(`{"lyrics":""}`))
+
+		_, err := srv.Client().GetLyrics(context.Background(), "xxxxxxxx")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+	})
+
+	t.Run("with lyrics flag on song", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(jiosaavn.Song{ID: "1xqHQw3J", Title: "Faded", HasLyrics: true, LyricsSnippet: "Where are you now?"})
+
+		song, err := srv.Client().GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.True(t, song.HasLyrics)
+		assert.Equal(t, "Where are you now?", song.LyricsSnippet)
+	})
+}
+
+func TestAutocomplete(t *testing.T) {
+	t.Run("with empty query", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.Autocomplete(context.Background(), "")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with query", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.Autocomplete(context.Background(), "alan walker")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, res.TopQuery.Items)
+		assert.NotEmpty(t, res.Songs.Items)
+		assert.NotEmpty(t, res.Albums.Items)
+		assert.NotEmpty(t, res.Artists.Items)
+	})
+
+	t.Run("with positions", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("autocomplete.get", []byte(`{
+			"topquery":{"position":1,"data":[{"id":"459320","title":"Arijit Singh","type":"artist","position":1}]},
+			"songs":{"position":2,"data":[{"id":"b","title":"Second","type":"song","position":2},{"id":"a","title":"First","type":"song","position":1,"more_info":{"primary_artists":"Alan Walker, Iselin Solheim"}}]},
+			"albums":{"position":3,"data":[{"id":"1","title":"Different World","type":"album","position":1,"more_info":{"year":"2018","song_pids":"a,b"}}]}
+		}`))
+
+		res, err := srv.Client().Autocomplete(context.Background(), "alan walker")
+		assert.NoError(t, err)
+		assert.Equal(t, "artist", res.TopQuery.Items[0].Value.Type)
+		assert.Equal(t, "Arijit Singh", res.TopQuery.Items[0].Value.Artist.Name)
+		assert.Nil(t, res.TopQuery.Items[0].Value.Song)
+
+		// ordered by position
+		assert.Equal(t, 2, res.Songs.Position)
+		assert.Equal(t, 1, res.Songs.Items[0].Position)
+		assert.Equal(t, "First", res.Songs.Items[0].Value.Title)
+		assert.Equal(t, 2, res.Songs.Items[1].Position)
+		assert.Len(t, res.Songs.Items[0].Value.PrimaryArtists, 2)
+		assert.Equal(t, 2018, res.Albums.Items[0].Value.Year)
+		assert.Equal(t, 2, res.Albums.Items[0].Value.SongCount)
+		assert.Empty(t, res.Shows.Items)
+		assert.Equal(t, "alan walker", srv.Requests()[0].Get("query"))
+	})
+}
+
+func TestGetSongRecommendations(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetSongRecommendations(context.Background(), "")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		songs, err := c.GetSongRecommendations(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, songs)
+	})
+
+	t.Run("with options that don't apply", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetSongRecommendations(context.Background(), "1xqHQw3J", jiosaavn.WithLimit(20))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "WithLimit doesn't apply to GetSongRecommendations")
+
+		_, err = c.SearchSongs(context.Background(), "Faded", jiosaavn.WithLanguages("english"))
+		assert.ErrorContains(t, err, "WithLanguages doesn't apply to SearchSongs")
+
+		_, err = c.SearchAlbums(context.Background(), "Faded", jiosaavn.WithoutExplicitContent())
+		assert.ErrorContains(t, err, "WithoutExplicitContent doesn't apply to SearchAlbums")
+
+		_, err = c.GetUserPlaylists(context.Background(), "priya.sharma", jiosaavn.WithSortOrder(jiosaavn.SortByLatest))
+		assert.ErrorContains(t, err, "WithSortOrder doesn't apply to GetUserPlaylists")
+	})
+
+	t.Run("with filters", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("reco.getreco", []byte(`[
+			{"id":"a","title":"Alone","language":"english","explicit_content":"0"},
+			{"id":"b","title":"Wolves","language":"english","explicit_content":"1"},
+			{"id":"c","title":"Tum Hi Ho","language":"hindi","explicit_content":"0"}
+		]`))
+
+		songs, err := srv.Client().GetSongRecommendations(context.Background(), "1xqHQw3J",
+			jiosaavn.WithLanguages("english"),
+			jiosaavn.WithoutExplicitContent(),
+		)
+		assert.NoError(t, err)
+		assert.Len(t, songs, 1)
+		assert.Equal(t, "Alone", songs[0].Title)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "1xqHQw3J", params.Get("pid"))
+		assert.Equal(t, "english", params.Get("language"))
+	})
+}
+
+func TestStation(t *testing.T) {
+	t.Run("with invalid seed", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.CreateStation(context.Background(), jiosaavn.ArtistStation(" ", "english"))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.CreateStation(context.Background(), jiosaavn.SongStation())
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.CreateStation(context.Background(), jiosaavn.StationSeed{})
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with station not created", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webradio.createEntityStation", []byte(`{}`))
+
+		var operations []string
+		var observedErr error
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				observedErr = next(ctx, call)
+				operations = append(operations, call.Operation)
+				return observedErr
+			}
+		}
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+
+		_, err := c.CreateStation(context.Background(), jiosaavn.SongStation("1xqHQw3J"))
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+		assert.ErrorIs(t, observedErr, jiosaavn.ErrUnexpectedResponse)
+
+		_, err = c.CreateStation(context.Background(), jiosaavn.StationSeed{})
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.ErrorIs(t, observedErr, jiosaavn.ErrInvalidArgument)
+		assert.Equal(t, []string{"CreateStation", "CreateStation"}, operations)
+	})
+
+	t.Run("with song seed", func(t *testing.T) {
+		c := newTestClient(t)
+		s, err := c.CreateStation(context.Background(), jiosaavn.SongStation("1xqHQw3J"))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, s.ID)
+
+		songs, err := s.NextSongs(context.Background(), 5)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, songs)
+	})
+
+	t.Run("with seeds", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		station := []byte(`{"stationid":"abc"}`)
+		srv.HandleJSON("webradio.createFeaturedStation", station)
+		srv.HandleJSON("webradio.createArtistStation", station)
+		srv.HandleJSON("webradio.createEntityStation", station)
+		c := srv.Client()
+
+		s, err := c.CreateStation(context.Background(), jiosaavn.FeaturedStation("English Hits", "english"))
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", s.ID)
+
+		_, err = c.CreateStation(context.Background(), jiosaavn.ArtistStation("Arijit Singh", "hindi"))
+		assert.NoError(t, err)
+
+		_, err = c.CreateStation(context.Background(), jiosaavn.SongStation("1xqHQw3J"))
+		assert.NoError(t, err)
+
+		requests := srv.Requests()
+		assert.Equal(t, "English Hits", requests[0].Get("name"))
+		assert.Equal(t, "english", requests[0].Get("language"))
+		assert.Equal(t, "Arijit Singh", requests[1].Get("query"))
+		assert.Equal(t, `["1xqHQw3J"]`, requests[2].Get("entity_id"))
+		assert.Equal(t, "queue", requests[2].Get("entity_type"))
+	})
+
+	t.Run("with played songs", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webradio.createEntityStation", []byte(`{"stationid":"abc"}`))
+		srv.HandleJSON("webradio.getSong", []byte(`{"0":{"song":{"id":"a","title":"Alone"}},"1":{"song":{"id":"b","title":"Silence"}},"stationid":"abc"}`))
+		c := srv.Client(jiosaavn.WithCache(jiosaavn.NewLRUCache(10)))
+
+		s, err := c.CreateStation(context.Background(), jiosaavn.SongStation("1xqHQw3J"))
+		assert.NoError(t, err)
+
+		_, err = s.NextSongs(context.Background(), 0)
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		songs, err := s.NextSongs(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Len(t, songs, 2)
+		assert.Equal(t, "Alone", songs[0].Title)
+
+		// already played songs are skipped
+		songs, err = s.NextSongs(context.Background(), 2)
+		assert.NoError(t, err)
+		assert.Empty(t, songs)
+
+		requests := srv.Requests()
+		assert.Len(t, requests, 3)
+		assert.Equal(t, "abc", requests[1].Get("stationid"))
+		assert.Equal(t, "2", requests[1].Get("k"))
+		assert.Equal(t, "1", requests[1].Get("next"))
+	})
+}
+
+func TestGetCharts(t *testing.T) {
+	t.Run("with no options", func(t *testing.T) {
+		c := newTestClient(t)
+		charts, err := c.GetCharts(context.Background())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, charts)
+
+		info, err := c.ExpandPlaylist(context.Background(), charts[0])
+		assert.NoError(t, err)
+		assert.Equal(t, charts[0].ID, info.ID)
+		assert.NotEmpty(t, info.Songs)
+	})
+
+	t.Run("with languages", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("content.getCharts", []byte(`[
+			{"id":"1","title":"Trending Today","language":"english"},
+			{"id":"2","title":"Hindi Superhits","language":"hindi"},
+			{"id":"3","title":"Viral Hits","language":"english"}
+		]`))
+
+		charts, err := srv.Client().GetCharts(context.Background(), jiosaavn.WithLanguages("english"))
+		assert.NoError(t, err)
+		assert.Len(t, charts, 2)
+		assert.Equal(t, "Viral Hits", charts[1].Title)
+		assert.Equal(t, "english", srv.Requests()[0].Get("language"))
+	})
+
+	t.Run("with expanded playlist", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddPlaylists(jiosaavn.PlaylistInfo{
+			Playlist: jiosaavn.Playlist{ID: "110858205", Title: "Trending Today"},
+			Songs:    []jiosaavn.Song{{ID: "1xqHQw3J", Title: "Faded"}},
+		})
+
+		var operation string
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				operation = call.Operation
+				return next(ctx, call)
+			}
+		}
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+
+		info, err := c.ExpandPlaylist(context.Background(), jiosaavn.Playlist{ID: "110858205"})
+		assert.NoError(t, err)
+		assert.Equal(t, "Trending Today", info.Title)
+		assert.Equal(t, "ExpandPlaylist", operation)
+	})
+
+	t.Run("with pagination", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("content.getCharts", []byte(listJSON("chart", 12)))
+		c := srv.Client()
+
+		charts, err := c.GetCharts(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, charts, 12)
+
+		charts, err = c.GetCharts(context.Background(), jiosaavn.WithPage(2))
+		assert.NoError(t, err)
+		assert.Len(t, charts, 2)
+		assert.Equal(t, "chart10", charts[0].ID)
+	})
+}
+
+func TestGetTopPlaylists(t *testing.T) {
+	t.Run("with no options", func(t *testing.T) {
+		c := newTestClient(t)
+		playlists, err := c.GetTopPlaylists(context.Background())
+		assert.NoError(t, err)
+		assert.NotEmpty(t, playlists)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("content.getFeaturedPlaylists", []byte(`{"data":`+listJSON("playlist", 3)+`,"count":120,"last_page":false}`))
+
+		playlists, err := srv.Client().GetTopPlaylists(context.Background(),
+			jiosaavn.WithLanguages("english", "hindi"),
+			jiosaavn.WithPage(2),
+		)
+		assert.NoError(t, err)
+		assert.Len(t, playlists, 3)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "2", params.Get("p"))
+		assert.Equal(t, "10", params.Get("n"))
+		assert.Equal(t, "english,hindi", params.Get("languages"))
+	})
+}
+
+func TestGetNewReleases(t *testing.T) {
+	t.Run("with invalid options", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetNewReleases(context.Background(), jiosaavn.WithPage(-1))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		// same limits as searches
+		_, err = c.GetNewReleases(context.Background(), jiosaavn.WithLimit(50))
+		assert.ErrorContains(t, err, "limit must be between 10 and 40")
+	})
+
+	t.Run("with no options", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.GetNewReleases(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Page)
+		assert.NotEmpty(t, res.Albums)
+		assert.False(t, res.Albums[0].ReleaseDate.IsZero())
+		assert.True(t, res.HasNext)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("content.getAlbums", []byte(`{"data":[{"id":"1","title":"Walkerworld 2.0","more_info":{"release_date":"2026-10-17"}}],"count":500,"last_page":false}`))
+
+		res, err := srv.Client().GetNewReleases(context.Background(), jiosaavn.WithLanguages("english", "hindi"))
+		assert.NoError(t, err)
+		assert.Equal(t, 500, res.Total)
+		assert.True(t, res.HasNext)
+		assert.Equal(t, time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC), res.Albums[0].ReleaseDate)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "1", params.Get("p"))
+		assert.Equal(t, "10", params.Get("n"))
+		assert.Equal(t, "english,hindi", params.Get("languages"))
+
+		srv.HandleJSON("content.getAlbums", []byte(`{"data":[],"count":500,"last_page":false}`))
+		next, err := res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, next.Page)
+		assert.False(t, next.HasNext)
+		assert.Equal(t, "2", srv.Requests()[1].Get("p"))
+		assert.Equal(t, "english,hindi", srv.Requests()[1].Get("languages"))
+
+		_, err = next.Next(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("with release date on album", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		releaseDate := time.Date(2015, time.December, 4, 0, 0, 0, 0, time.UTC)
+		srv.AddAlbums(jiosaavn.AlbumInfo{
+			Album: jiosaavn.Album{ID: "2228196", Title: "Faded", ReleaseDate: releaseDate},
+			Songs: []jiosaavn.Song{{ID: "1xqHQw3J", Title: "Faded"}},
+		})
+
+		album, err := srv.Client().GetAlbumById(context.Background(), "2228196")
+		assert.NoError(t, err)
+		assert.Equal(t, releaseDate, album.ReleaseDate)
+	})
+}
+
+func TestGetHome(t *testing.T) {
+	t.Run("with languages", func(t *testing.T) {
+		c := newTestClient(t)
+		modules, err := c.GetHome(context.Background(), "english", "hindi")
+		assert.NoError(t, err)
+		assert.NotEmpty(t, modules)
+		for i := 1; i < len(modules); i++ {
+			assert.LessOrEqual(t, modules[i-1].Position, modules[i].Position)
+		}
+	})
+
+	t.Run("with modules", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.getLaunchData", []byte(`{
+			"new_trending":[{"id":"1xqHQw3J","title":"Faded","type":"song"},{"id":"1","title":"Walkerworld","type":"album"}],
+			"radio":[{"title":"Arijit Singh","type":"radio_station","more_info":{"featured_station_type":"artist","query":"Arijit Singh","language":"hindi"}}],
+			"promo:vx:data:68":[{"id":"1","title":"Mix","type":"mix"}],
+			"modules":{
+				"radio":{"source":"radio","position":2,"title":"Radio Stations"},
+				"new_trending":{"source":"new_trending","position":1,"title":"Trending Now"},
+				"promo:vx:data:68":{"source":"promo:vx:data:68","position":3,"title":"Editorial Picks &amp; More"}
+			},
+			"global_config":{}
+		}`))
+		c := srv.Client()
+
+		modules, err := c.GetHome(context.Background(), "english", "hindi")
+		assert.NoError(t, err)
+		assert.Len(t, modules, 3)
+		assert.Equal(t, "Trending Now", modules[0].Title)
+		assert.Equal(t, "Faded", modules[0].Items[0].Song.Title)
+		assert.Equal(t, "Walkerworld", modules[0].Items[1].Album.Title)
+		assert.Equal(t, "Editorial Picks & More", modules[2].Title)
+		assert.Equal(t, "mix", modules[2].Items[0].Type)
+		assert.Equal(t, "english,hindi", srv.Requests()[0].Get("language"))
+
+		// radio stations can be created from their seed
+		srv.HandleJSON("webradio.createArtistStation", []byte(`{"stationid":"abc"}`))
+		station, err := c.CreateStation(context.Background(), modules[1].Items[0].Station.Seed)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", station.ID)
+		assert.Equal(t, "Arijit Singh", srv.Requests()[1].Get("query"))
+	})
+
+	t.Run("with malformed items", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.getLaunchData", []byte(`{
+			"new_trending":[{"id":"1xqHQw3J","title":"Faded","type":"song"},{"id":"1","title":["Walkerworld"],"type":"album"},"promo",{"id":"1","type":"mix"}],
+			"modules":{"new_trending":{"source":"new_trending","position":1,"title":"Trending Now"}}
+		}`))
+
+		modules, err := srv.Client().GetHome(context.Background())
+		assert.NoError(t, err)
+		items := modules[0].Items
+		assert.Len(t, items, 4)
+		assert.Equal(t, "Faded", items[0].Song.Title)
+		assert.Nil(t, items[0].Raw)
+
+		assert.Equal(t, "album", items[1].Type)
+		assert.Nil(t, items[1].Album)
+		assert.JSONEq(t, `{"id":"1","title":["Walkerworld"],"type":"album"}`, string(items[1].Raw))
+
+		assert.Empty(t, items[2].Type)
+		assert.JSONEq(t, `"promo"`, string(items[2].Raw))
+
+		assert.Equal(t, "mix", items[3].Type)
+		assert.JSONEq(t, `{"id":"1","type":"mix"}`, string(items[3].Raw))
+	})
+
+	t.Run("with malformed modules", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.getLaunchData", []byte(`{"modules":{"new_trending":{"position":"1"}}}`))
+
+		_, err := srv.Client().GetHome(context.Background())
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+	})
+}
+
+func TestParseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want jiosaavn.Link
+	}{
+		{"https://www.jiosaavn.com/song/faded/IlkOBzVWZWU", jiosaavn.Link{Type: jiosaavn.LinkSong, Slug: "faded", Token: "IlkOBzVWZWU"}},
+		{"www.jiosaavn.com/album/different-world/2DFmMe26K-Y_/", jiosaavn.Link{Type: jiosaavn.LinkAlbum, Slug: "different-world", Token: "2DFmMe26K-Y_"}},
+		{"https://www.jiosaavn.com/featured/best-of-alan-walker/ufWk1U2V3VhFo9wdEAzFBA__?referrer=share", jiosaavn.Link{Type: jiosaavn.LinkPlaylist, Slug: "best-of-alan-walker", Token: "ufWk1U2V3VhFo9wdEAzFBA__"}},
+		{"https://www.jiosaavn.com/s/playlist/2279fb4d16f1ee8f8a0f1a3e5e6b6f3a/my-mix/Xj3Ui2VZgDZFo9wdEAzFBA__", jiosaavn.Link{Type: jiosaavn.LinkPlaylist, Slug: "my-mix", Token: "Xj3Ui2VZgDZFo9wdEAzFBA__"}},
+		{"https://jiosaavn.com/artist/alan-walker-songs/7WPHpvNGFPI_", jiosaavn.Link{Type: jiosaavn.LinkArtist, Slug: "alan-walker-songs", Token: "7WPHpvNGFPI_"}},
+		{"https://www.jiosaavn.com/shows/walking-with-alan/1/RvQzfl3bZRE_", jiosaavn.Link{Type: jiosaavn.LinkShow, Slug: "walking-with-alan", Token: "RvQzfl3bZRE_"}},
+		{"https://www.jiosaavn.com/label/mer-musikk-albums/AR4YeHMfbiQ_", jiosaavn.Link{Type: jiosaavn.LinkLabel, Slug: "mer-musikk-albums", Token: "AR4YeHMfbiQ_"}},
+	}
+
+	for _, tt := range tests {
+		link, err := jiosaavn.ParseURL(tt.url)
+		assert.NoError(t, err, tt.url)
+		assert.Equal(t, tt.want, link, tt.url)
+	}
+
+	for _, invalid := range []string{"", "https://example.com/song/faded/IlkOBzVWZWU", "https://www.jiosaavn.com/song/faded", "https://www.jiosaavn.com/radio/english"} {
+		_, err := jiosaavn.ParseURL(invalid)
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument, invalid)
+	}
+}
+
+func TestResolveURL(t *testing.T) {
+	t.Run("with invalid url", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.ResolveURL(context.Background(), "https://example.com")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with observed calls", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`{"songs":[]}`))
+
+		var operations []string
+		var errs []error
+		observe := func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				err := next(ctx, call)
+				operations = append(operations, call.Operation)
+				errs = append(errs, err)
+				return err
+			}
+		}
+		c := srv.Client(jiosaavn.WithMiddleware(observe))
+
+		_, err := c.ResolveURL(context.Background(), "https://example.com")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		_, err = c.ResolveURL(context.Background(), "https://www.jiosaavn.com/song/faded/IlkOBzVWZWU")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+
+		assert.Equal(t, []string{"ResolveURL", "ResolveURL"}, operations)
+		assert.ErrorIs(t, errs[0], jiosaavn.ErrInvalidArgument)
+		assert.ErrorIs(t, errs[1], jiosaavn.ErrNotFound)
+	})
+
+	t.Run("with song url", func(t *testing.T) {
+		c := newTestClient(t)
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+
+		res, err := c.ResolveURL(context.Background(), song.PermanentURL)
+		assert.NoError(t, err)
+		assert.Equal(t, jiosaavn.LinkSong, res.Type)
+		assert.Equal(t, song.ID, res.Song.ID)
+	})
+
+	t.Run("with artist url", func(t *testing.T) {
+		c := newTestClient(t)
+		artist, err := c.GetArtistById(context.Background(), "459320")
+		assert.NoError(t, err)
+
+		res, err := c.ResolveURL(context.Background(), artist.PermanentURL)
+		assert.NoError(t, err)
+		assert.Equal(t, jiosaavn.LinkArtist, res.Type)
+		assert.Equal(t, artist.ID, res.Artist.ID)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`))
+
+		res, err := srv.Client().ResolveURL(context.Background(), "https://www.jiosaavn.com/song/faded/IlkOBzVWZWU")
+		assert.NoError(t, err)
+		assert.Equal(t, "Faded", res.Song.Title)
+		assert.Nil(t, res.Album)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "IlkOBzVWZWU", params.Get("token"))
+		assert.Equal(t, "song", params.Get("type"))
+	})
+
+	t.Run("with show url", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`{
+			"show_details":{"id":"52416870","title":"Walking with Alan","type":"show","more_info":{"description":"Stories &amp; music","total_episodes":"12"}},
+			"seasons":[{"id":"1","title":"Season 1","more_info":{"season_number":"1"}}],
+			"episodes":[{"id":"e1","title":"Episode 1","type":"episode"}]
+		}`))
+
+		res, err := srv.Client().ResolveURL(context.Background(), "https://www.jiosaavn.com/shows/walking-with-alan/1/RvQzfl3bZRE_")
+		assert.NoError(t, err)
+		assert.Equal(t, jiosaavn.LinkShow, res.Type)
+		assert.Equal(t, "52416870", res.Show.ID)
+		assert.Equal(t, "Stories & music", res.Show.Description)
+		assert.Equal(t, 12, res.Show.EpisodeCount)
+		assert.Len(t, res.Show.Seasons, 1)
+		assert.Len(t, res.Show.Episodes, 1)
+	})
+
+	t.Run("with unknown album", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`[]`))
+
+		_, err := srv.Client().ResolveURL(context.Background(), "https://www.jiosaavn.com/album/unknown/xxxxxxxx")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+	})
+}
+
+func TestSearchPodcasts(t *testing.T) {
+	t.Run("with empty search query", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.SearchPodcasts(context.Background(), "")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with no search options", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.SearchPodcasts(context.Background(), "cricket")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Page)
+		assert.NotEmpty(t, res.Shows)
+	})
+}
+
+func TestGetShowById(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetShowById(context.Background(), "")
+		assert.ErrorContains(t, err, "show id cannot be empty")
+	})
+
+	t.Run("with valid id", func(t *testing.T) {
+		c := newTestClient(t)
+		res, err := c.SearchPodcasts(context.Background(), "cricket")
+		assert.NoError(t, err)
+		if !assert.NotEmpty(t, res.Shows) {
+			return
+		}
+
+		show, err := c.GetShowById(context.Background(), res.Shows[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, res.Shows[0].ID, show.ID)
+		assert.NotEmpty(t, show.Seasons)
+
+		episodes, err := c.GetShowEpisodes(context.Background(), show.ID, show.Seasons[0].Number)
+		assert.NoError(t, err)
+		if !assert.NotEmpty(t, episodes.Episodes) {
+			return
+		}
+
+		episode, err := c.GetEpisodeById(context.Background(), episodes.Episodes[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, episodes.Episodes[0].ID, episode.ID)
+		assert.NotEmpty(t, episode.MediaURL)
+		assert.Positive(t, episode.Duration)
+	})
+
+	t.Run("with invalid id", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("show.getHomePage", []byte(`{"show_details":{}}`))
+
+		_, err := srv.Client().GetShowById(context.Background(), "0")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+	})
+}
+
+func TestGetShowEpisodes(t *testing.T) {
+	t.Run("with invalid season", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetShowEpisodes(context.Background(), "52416870", 0)
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with alphabetical sort order", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetShowEpisodes(context.Background(), "52416870", 1, jiosaavn.WithSortOrder(jiosaavn.SortByAlphabetical))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.ErrorContains(t, err, "episodes cannot be sorted by alphabetical")
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("show.getAllEpisodes", []byte(listJSON("episode", 10)))
+
+		res, err := srv.Client().GetShowEpisodes(context.Background(), "52416870", 1, jiosaavn.WithSortOrder(jiosaavn.SortByLatest))
+		assert.NoError(t, err)
+		assert.Equal(t, 10, res.Size)
+		assert.True(t, res.HasNext)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "52416870", params.Get("show_id"))
+		assert.Equal(t, "1", params.Get("season_number"))
+		assert.Equal(t, "1", params.Get("p"))
+		assert.Equal(t, "10", params.Get("n"))
+		assert.Equal(t, "desc", params.Get("sort_order"))
+
+		srv.HandleJSON("show.getAllEpisodes", []byte(`[]`))
+		next, err := res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, next.Page)
+		assert.False(t, next.HasNext)
+		assert.Equal(t, "2", srv.Requests()[1].Get("p"))
+	})
+}
+
+func TestGetEpisodeById(t *testing.T) {
+	t.Run("with empty id", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetEpisodeById(context.Background(), "")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+	})
+
+	t.Run("with invalid id", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("episode.getDetails", []byte(`{"episodes":[]}`))
+
+		_, err := srv.Client().GetEpisodeById(context.Background(), "xxxxxxxx")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+		assert.Equal(t, "xxxxxxxx", srv.Requests()[0].Get("episode_id"))
+	})
+}
+
+func TestGetLabel(t *testing.T) {
+	t.Run("with empty token", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetLabel(context.Background(), "")
+		assert.ErrorContains(t, err, "label token cannot be empty")
+	})
+
+	t.Run("with label of song", func(t *testing.T) {
+		c := newTestClient(t)
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+
+		label, err := c.GetLabel(context.Background(), song.LabelToken())
+		assert.NoError(t, err)
+		assert.Equal(t, song.LabelID, label.ID)
+		assert.NotEmpty(t, label.TopSongs)
+	})
+
+	t.Run("with label token of song", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(jiosaavn.Song{
+			ID:       "1xqHQw3J",
+			Title:    "Faded",
+			LabelID:  "2431364",
+			LabelURL: "https://www.jiosaavn.com/label/mer-musikk-albums/AR4YeHMfbiQ_",
+		})
+		srv.HandleJSON("webapi.get", []byte(`{"labelId":"2431364","name":"MER Musikk","topSongs":{"songs":[{"id":"1xqHQw3J","title":"Faded"}],"total":1},"topAlbums":{"albums":[],"total":0}}`))
+		c := srv.Client()
+
+		song, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "AR4YeHMfbiQ_", song.LabelToken())
+
+		label, err := c.GetLabel(context.Background(), song.LabelToken())
+		assert.NoError(t, err)
+		assert.Equal(t, song.LabelID, label.ID)
+		assert.Equal(t, "AR4YeHMfbiQ_", srv.Requests()[1].Get("token"))
+
+		assert.Empty(t, jiosaavn.Song{}.LabelToken())
+	})
+
+	t.Run("with operation", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`{"labelId":"1","name":"MER Musikk","topSongs":{"songs":[],"total":0},"topAlbums":{"albums":[],"total":0}}`))
+		var operation string
+		c := srv.Client(jiosaavn.WithMiddleware(func(next jiosaavn.Handler) jiosaavn.Handler {
+			return func(ctx context.Context, call *jiosaavn.Call) error {
+				operation = call.Operation
+				return next(ctx, call)
+			}
+		}))
+
+		_, err := c.GetLabel(context.Background(), "AR4YeHMfbiQ_")
+		assert.NoError(t, err)
+		assert.Equal(t, "GetLabel", operation)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "AR4YeHMfbiQ_", params.Get("token"))
+		assert.Equal(t, "label", params.Get("type"))
+	})
+
+	t.Run("with empty page", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("webapi.get", []byte(`{"labelId":"2431364","name":"MER Musikk","topSongs":{"songs":[],"total":40},"topAlbums":{"albums":[],"total":20}}`))
+
+		label, err := srv.Client().GetLabel(context.Background(), "AR4YeHMfbiQ_", jiosaavn.WithPage(5))
+		assert.NoError(t, err)
+		assert.False(t, label.HasNext)
+	})
+}
+
+func TestGetUserPlaylists(t *testing.T) {
+	t.Run("with empty username", func(t *testing.T) {
+		c := jiosaavn.NewClient(nil)
+		_, err := c.GetUserPlaylists(context.Background(), " ")
+		assert.ErrorContains(t, err, "username cannot be empty")
+	})
+
+	t.Run("with owner of playlist", func(t *testing.T) {
+		c := newTestClient(t)
+		playlist, err := c.GetPlaylistById(context.Background(), "1141249906")
+		assert.NoError(t, err)
+		if len(playlist.Owner.Username) == 0 {
+			t.Skip("playlist has no owner")
+		}
+
+		res, err := c.GetUserPlaylists(context.Background(), playlist.Owner.Username)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Page)
+		assert.NotEmpty(t, res.Playlists)
+	})
+
+	t.Run("with params", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("user.getPlaylists", []byte(`{"total":25,"playlists":`+listJSON("playlist", 10)+`}`))
+
+		res, err := srv.Client().GetUserPlaylists(context.Background(), "priya.sharma")
+		assert.NoError(t, err)
+		assert.Equal(t, 25, res.Total)
+		assert.True(t, res.HasNext)
+
+		params := srv.Requests()[0]
+		assert.Equal(t, "priya.sharma", params.Get("username"))
+		assert.Equal(t, "1", params.Get("p"))
+		assert.Equal(t, "10", params.Get("n"))
+
+		next, err := res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, next.Page)
+		assert.Equal(t, "2", srv.Requests()[1].Get("p"))
+	})
+
+	t.Run("with empty page", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.HandleJSON("user.getPlaylists", []byte(`{"total":25,"playlists":[]}`))
+
+		res, err := srv.Client().GetUserPlaylists(context.Background(), "priya.sharma", jiosaavn.WithPage(2))
+		assert.NoError(t, err)
+		assert.False(t, res.HasNext)
+	})
+
+	t.Run("with playlist owner", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		owner := jiosaavn.User{
+			ID:        "9c3f4b5e8d7a6b1c2d3e4f5a6b7c8d9e",
+			Username:  "priya.sharma",
+			FirstName: "Priya",
+			LastName:  "Sharma",
+		}
+		srv.AddPlaylists(jiosaavn.PlaylistInfo{
+			Playlist:      jiosaavn.Playlist{ID: "1134543272", Title: "Morning Run"},
+			FollowerCount: 42,
+			Owner:         owner,
+			Songs:         []jiosaavn.Song{{ID: "1xqHQw3J", Title: "Faded"}},
+		})
+
+		playlist, err := srv.Client().GetPlaylistById(context.Background(), "1134543272")
+		assert.NoError(t, err)
+		assert.Equal(t, 42, playlist.FollowerCount)
+		assert.Equal(t, owner, playlist.Owner)
+		assert.Equal(t, "Priya Sharma", playlist.Owner.Name())
+	})
+}
@@ -0,0 +1,186 @@
+package jiosaavntest
+
+import (
+	"bytes"
+	"crypto/des"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/ppalone/jiosaavn"
+)
+
+// JioSaavn response encoders, the inverse of the client decoders.
+
+func encodeBool(b bool) string {
+	if b {
+		return "1"
+	}
+
+	return "0"
+}
+
+func encodeArtist(a jiosaavn.Artist) map[string]any {
+	return map[string]any{
+		"id":        a.ID,
+		"name":      a.Name,
+		"image":     a.Image,
+		"perma_url": a.PermanentURL,
+		"type":      "artist",
+		"role":      "singer",
+	}
+}
+
+func encodeArtists(artists []jiosaavn.Artist) []map[string]any {
+	res := make([]map[string]any, 0, len(artists))
+	for _, a := range artists {
+		res = append(res, encodeArtist(a))
+	}
+
+	return res
+}
+
+func encodeSong(s jiosaavn.Song) map[string]any {
+	return map[string]any{
+		"id":               s.ID,
+		"title":            s.Title,
+		"subtitle":         s.Subtitle,
+		"type":             "song",
+		"perma_url":        s.PermanentURL,
+		"image":            s.Image,
+		"language":         s.Language,
+		"year":             s.Year,
+		"play_count":       strconv.Itoa(s.PlayCount),
+		"explicit_content": encodeBool(s.ExplicitContent),
+		"more_info": map[string]any{
+			"music":               s.Music,
+			"album_id":            s.AlbumId,
+			"album":               s.AlbumName,
+			"album_url":           s.AlbumURL,
+			"label":               s.Label,
+			"label_id":            s.LabelID,
+			"label_url":           s.LabelURL,
+			"duration":            strconv.Itoa(s.Duration),
+			"encrypted_media_url": encryptMediaURL(s.MediaURL),
+			"has_lyrics":          strconv.FormatBool(s.HasLyrics),
+			"lyrics_snippet":      s.LyricsSnippet,
+			"artistMap": map[string]any{
+				"primary_artists":  encodeArtists(s.PrimaryArtists),
+				"featured_artists": encodeArtists(s.FeaturedArtists),
+				"artists":          encodeArtists(append(append([]jiosaavn.Artist{}, s.PrimaryArtists...), s.FeaturedArtists...)),
+			},
+		},
+	}
+}
+
+func encodeSongs(songs []jiosaavn.Song) []map[string]any {
+	res := make([]map[string]any, 0, len(songs))
+	for _, s := range songs {
+		res = append(res, encodeSong(s))
+	}
+
+	return res
+}
+
+func encodeAlbum(a jiosaavn.Album, songs []jiosaavn.Song) map[string]any {
+	songCount := a.SongCount
+	if songCount == 0 {
+		songCount = len(songs)
+	}
+
+	return map[string]any{
+		"id":         a.ID,
+		"title":      a.Title,
+		"subtitle":   a.Subtitle,
+		"type":       "album",
+		"perma_url":  a.PermanentURL,
+		"image":      a.Image,
+		"language":   a.Language,
+		"year":       strconv.Itoa(a.Year),
+		"play_count": strconv.Itoa(a.PlayCount),
+		"list_count": strconv.Itoa(len(songs)),
+		"list":       encodeSongs(songs),
+		"more_info": map[string]any{
+			"song_count":   strconv.Itoa(songCount),
+			"release_date": encodeDate(a.ReleaseDate),
+			"artistMap": map[string]any{
+				"primary_artists":  encodeArtists(a.PrimaryArtists),
+				"featured_artists": encodeArtists(a.FeaturedArtists),
+			},
+		},
+	}
+}
+
+func encodePlaylist(p jiosaavn.Playlist) map[string]any {
+	return map[string]any{
+		"id":               p.ID,
+		"title":            p.Title,
+		"type":             "playlist",
+		"image":            p.Image,
+		"perma_url":        p.PermanentURL,
+		"explicit_content": encodeBool(p.ExplicitContent),
+		"more_info": map[string]any{
+			"song_count": strconv.Itoa(p.SongCount),
+			"language":   p.Language,
+		},
+	}
+}
+
+func encodePlaylistInfo(p jiosaavn.PlaylistInfo, songs []jiosaavn.Song) map[string]any {
+	songCount := p.SongCount
+	if songCount == 0 {
+		songCount = len(p.Songs)
+	}
+
+	return map[string]any{
+		"id":               p.ID,
+		"title":            p.Title,
+		"type":             "playlist",
+		"perma_url":        p.PermanentURL,
+		"image":            p.Image,
+		"language":         p.Language,
+		"play_count":       strconv.Itoa(p.PlayCount),
+		"explicit_content": encodeBool(p.ExplicitContent),
+		"list_count":       strconv.Itoa(songCount),
+		"list":             encodeSongs(songs),
+		"more_info": map[string]any{
+			"artists":        encodeArtists(p.Artists),
+			"uid":            p.Owner.ID,
+			"username":       p.Owner.Username,
+			"firstname":      p.Owner.FirstName,
+			"lastname":       p.Owner.LastName,
+			"follower_count": strconv.Itoa(p.FollowerCount),
+		},
+	}
+}
+
+// encryptMediaURL encrypts the url the way JioSaavn does
+func encryptMediaURL(mediaURL string) string {
+	if len(mediaURL) == 0 {
+		return ""
+	}
+
+	block, err := des.NewCipher([]byte("38346591"))
+	if err != nil {
+		return ""
+	}
+
+	blockSize := block.BlockSize()
+	padding := blockSize - len(mediaURL)%blockSize
+	data := append([]byte(mediaURL), bytes.Repeat([]byte{byte(padding)}, padding)...)
+
+	encrypted := make([]byte, len(data))
+	for start := 0; start < len(data); start += blockSize {
+		block.Encrypt(encrypted[start:start+blockSize], data[start:start+blockSize])
+	}
+
+	return base64.StdEncoding.EncodeToString(encrypted)
+}
+
+func encodeDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,396 @@
+// Package jiosaavntest provides an in-process fake of the JioSaavn API
+// to test code using jiosaavn.Client without network access.
+package jiosaavntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ppalone/jiosaavn"
+)
+
+// endpoints served from seeded entities
+const (
+	searchSongsEndpoint     = "search.getResults"
+	searchArtistsEndpoint   = "search.getArtistResults"
+	searchPlaylistsEndpoint = "search.getPlaylistResults"
+	searchAlbumsEndpoint    = "search.getAlbumResults"
+	getSongById             = "song.getDetails"
+	getPlaylistById         = "playlist.getDetails"
+	getAlbumById            = "content.getAlbumDetails"
+)
+
+// HandlerFunc returns the response of a call, it is encoded as json.
+// Returning an error responds with an in-band JioSaavn error payload.
+type HandlerFunc func(params url.Values) (any, error)
+
+// Fault is an injected failure.
+type Fault struct {
+	// StatusCode of the response, defaults to 500.
+	StatusCode int
+	// Body of the response.
+	Body string
+	// Header of the response, e.g. Retry-After.
+	Header http.Header
+	// Times is the number of requests to fail, zero fails every request.
+	Times int
+}
+
+// Server is a fake JioSaavn API dispatching on the __call parameter.
+type Server struct {
+	URL string
+
+	srv *httptest.Server
+
+	mu        sync.Mutex
+	songs     []jiosaavn.Song
+	albums    []jiosaavn.AlbumInfo
+	playlists []jiosaavn.PlaylistInfo
+	artists   []jiosaavn.Artist
+	handlers  map[string]HandlerFunc
+	faults    map[string]*Fault
+	latency   time.Duration
+	requests  []url.Values
+}
+
+// NewServer starts a new fake JioSaavn API, it must be closed with Close
+func NewServer() *Server {
+	s := &Server{
+		handlers: make(map[string]HandlerFunc),
+		faults:   make(map[string]*Fault),
+	}
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a client talking to the server
+func (s *Server) Client(opts ...jiosaavn.ClientOption) *jiosaavn.Client {
+	opts = append([]jiosaavn.ClientOption{jiosaavn.WithBaseURL(s.URL)}, opts...)
+	return jiosaavn.NewClient(s.srv.Client(), opts...)
+}
+
+// AddSongs seeds songs served by song.getDetails and search.getResults
+func (s *Server) AddSongs(songs ...jiosaavn.Song) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.songs = append(s.songs, songs...)
+}
+
+// AddAlbums seeds albums served by content.getAlbumDetails and search.getAlbumResults
+func (s *Server) AddAlbums(albums ...jiosaavn.AlbumInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.albums = append(s.albums, albums...)
+}
+
+// AddPlaylists seeds playlists served by playlist.getDetails and search.getPlaylistResults
+func (s *Server) AddPlaylists(playlists ...jiosaavn.PlaylistInfo) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.playlists = append(s.playlists, playlists...)
+}
+
+// AddArtists seeds artists served by search.getArtistResults
+func (s *Server) AddArtists(artists ...jiosaavn.Artist) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.artists = append(s.artists, artists...)
+}
+
+// HandleFunc serves every request to the __call endpoint with fn,
+// taking precedence over seeded entities
+func (s *Server) HandleFunc(call string, fn HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[call] = fn
+}
+
+// HandleJSON serves every request to the __call endpoint with the raw json body
+func (s *Server) HandleJSON(call string, body []byte) {
+	raw := json.RawMessage(body)
+	s.HandleFunc(call, func(params url.Values) (any, error) {
+		return raw, nil
+	})
+}
+
+// LoadFixture serves every request to the __call endpoint with the json file at path
+func (s *Server) LoadFixture(call string, path string) error {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if !json.Valid(body) {
+		return fmt.Errorf("jiosaavntest: fixture %s is not valid json", path)
+	}
+
+	s.HandleJSON(call, body)
+	return nil
+}
+
+// InjectFault fails requests to the __call endpoint, an empty call fails every endpoint
+func (s *Server) InjectFault(call string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f.StatusCode == 0 {
+		f.StatusCode = http.StatusInternalServerError
+	}
+	s.faults[call] = &f
+}
+
+// SetLatency delays every response by d
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+// Requests returns the query parameters of every request received
+func (s *Server) Requests() []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]url.Values(nil), s.requests...)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	call := params.Get("__call")
+
+	s.mu.Lock()
+	s.requests = append(s.requests, params)
+	latency := s.latency
+	fault := s.fault(call)
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(latency):
+		}
+	}
+
+	if fault != nil {
+		for k, values := range fault.Header {
+			w.Header()[k] = values
+		}
+		w.WriteHeader(fault.StatusCode)
+		w.Write([]byte(fault.Body))
+		return
+	}
+
+	res, err := s.dispatch(call, params)
+	if err != nil {
+		res = map[string]any{
+			"error": map[string]any{
+				"code": "INPUT_INVALID",
+				"msg":  err.Error(),
+			},
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	json.NewEncoder(w).Encode(res)
+}
+
+// fault returns the fault to respond with, consuming it
+func (s *Server) fault(call string) *Fault {
+	for _, key := range []string{call, ""} {
+		f, ok := s.faults[key]
+		if !ok {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				delete(s.faults, key)
+			}
+		}
+
+		return f
+	}
+
+	return nil
+}
+
+func (s *Server) dispatch(call string, params url.Values) (any, error) {
+	s.mu.Lock()
+	fn, ok := s.handlers[call]
+	s.mu.Unlock()
+	if ok {
+		return fn(params)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch call {
+	case searchSongsEndpoint:
+		return s.searchSongs(params), nil
+	case searchAlbumsEndpoint:
+		return s.searchAlbums(params), nil
+	case searchArtistsEndpoint:
+		return s.searchArtists(params), nil
+	case searchPlaylistsEndpoint:
+		return s.searchPlaylists(params), nil
+	case getSongById:
+		return s.getSongs(params), nil
+	case getAlbumById:
+		return s.getAlbum(params), nil
+	case getPlaylistById:
+		return s.getPlaylist(params), nil
+	default:
+		return nil, fmt.Errorf("unknown __call %q", call)
+	}
+}
+
+// paginate returns the [start, end) bounds of the requested page and the 1 based start
+func paginate(params url.Values, total int) (int, int, int) {
+	p, err := strconv.Atoi(params.Get("p"))
+	if err != nil || p < 1 {
+		p = 1
+	}
+
+	n, err := strconv.Atoi(params.Get("n"))
+	if err != nil || n < 1 {
+		n = 10
+	}
+
+	start := min((p-1)*n, total)
+	end := min(start+n, total)
+
+	return start, end, (p-1)*n + 1
+}
+
+func searchPage[T any](params url.Values, matches []T, encode func(T) map[string]any) map[string]any {
+	start, end, first := paginate(params, len(matches))
+
+	results := make([]map[string]any, 0, end-start)
+	for _, m := range matches[start:end] {
+		results = append(results, encode(m))
+	}
+
+	return map[string]any{
+		"total":   len(matches),
+		"start":   first,
+		"results": results,
+	}
+}
+
+func matches(q, title string) bool {
+	return strings.Contains(strings.ToLower(title), strings.ToLower(strings.TrimSpace(q)))
+}
+
+func (s *Server) searchSongs(params url.Values) any {
+	res := make([]jiosaavn.Song, 0)
+	for _, song := range s.songs {
+		if matches(params.Get("q"), song.Title) {
+			res = append(res, song)
+		}
+	}
+
+	return searchPage(params, res, encodeSong)
+}
+
+func (s *Server) searchAlbums(params url.Values) any {
+	res := make([]jiosaavn.Album, 0)
+	for _, album := range s.albums {
+		if matches(params.Get("q"), album.Title) {
+			res = append(res, album.Album)
+		}
+	}
+
+	return searchPage(params, res, func(a jiosaavn.Album) map[string]any {
+		return encodeAlbum(a, nil)
+	})
+}
+
+func (s *Server) searchArtists(params url.Values) any {
+	res := make([]jiosaavn.Artist, 0)
+	for _, artist := range s.artists {
+		if matches(params.Get("q"), artist.Name) {
+			res = append(res, artist)
+		}
+	}
+
+	return searchPage(params, res, encodeArtist)
+}
+
+func (s *Server) searchPlaylists(params url.Values) any {
+	res := make([]jiosaavn.Playlist, 0)
+	for _, playlist := range s.playlists {
+		if matches(params.Get("q"), playlist.Title) {
+			res = append(res, playlist.Playlist)
+		}
+	}
+
+	return searchPage(params, res, encodePlaylist)
+}
+
+func (s *Server) getSongs(params url.Values) any {
+	songs := make([]jiosaavn.Song, 0)
+	for _, id := range strings.Split(params.Get("pids"), ",") {
+		for _, song := range s.songs {
+			if song.ID == strings.TrimSpace(id) {
+				songs = append(songs, song)
+			}
+		}
+	}
+
+	return map[string]any{
+		"songs": encodeSongs(songs),
+	}
+}
+
+func (s *Server) getAlbum(params url.Values) any {
+	for _, album := range s.albums {
+		if album.ID == params.Get("albumid") {
+			return encodeAlbum(album.Album, album.Songs)
+		}
+	}
+
+	return map[string]any{}
+}
+
+func (s *Server) getPlaylist(params url.Values) any {
+	for _, playlist := range s.playlists {
+		if playlist.ID != params.Get("listid") {
+			continue
+		}
+
+		songs := playlist.Songs
+		if params.Has("p") || params.Has("n") {
+			start, end, _ := paginate(params, len(songs))
+			songs = songs[start:end]
+		}
+
+		return encodePlaylistInfo(playlist, songs)
+	}
+
+	return map[string]any{}
+}
@@ -0,0 +1,164 @@
+package jiosaavntest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ppalone/jiosaavn"
+	"github.com/ppalone/jiosaavn/jiosaavntest"
+	"github.com/stretchr/testify/assert"
+)
+
+func seedSongs(n int) []jiosaavn.Song {
+	songs := make([]jiosaavn.Song, 0, n)
+	for i := 0; i < n; i++ {
+		songs = append(songs, jiosaavn.Song{
+			ID:       fmt.Sprintf("song%02d", i),
+			Title:    fmt.Sprintf("Faded %d", i),
+			Year:     "2015",
+			Duration: 212,
+			PrimaryArtists: []jiosaavn.Artist{
+				{ID: "456323", Name: "Alan Walker"},
+			},
+			FeaturedArtists: []jiosaavn.Artist{},
+		})
+	}
+
+	return songs
+}
+
+func TestServer(t *testing.T) {
+	t.Run("with seeded song", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		want := seedSongs(1)[0]
+		want.MediaURL = "https://aac.saavncdn.com/song_96.mp4"
+		srv.AddSongs(want)
+
+		got, err := srv.Client().GetSongById(context.Background(), want.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("with unknown song", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		_, err := srv.Client().GetSongById(context.Background(), "missing")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+	})
+
+	t.Run("with search pagination", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(seedSongs(25)...)
+
+		res, err := srv.Client().SearchSongs(context.Background(), "faded")
+		assert.NoError(t, err)
+		assert.Equal(t, 10, res.Size)
+		assert.Equal(t, 25, res.Total)
+		assert.True(t, res.HasNext)
+
+		res, err = res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Page)
+		assert.Equal(t, "song10", res.Songs[0].ID)
+
+		res, err = res.Next(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res.Size)
+		assert.False(t, res.HasNext)
+	})
+
+	t.Run("with seeded album and playlist", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		songs := seedSongs(3)
+		srv.AddAlbums(jiosaavn.AlbumInfo{
+			Album: jiosaavn.Album{ID: "27007462", Title: "Different World", Year: 2018},
+			Songs: songs,
+		})
+		srv.AddPlaylists(jiosaavn.PlaylistInfo{
+			Playlist: jiosaavn.Playlist{ID: "1141249906", Title: "Pop Hits"},
+			Songs:    songs,
+			Artists:  songs[0].PrimaryArtists,
+		})
+
+		c := srv.Client()
+		album, err := c.GetAlbumById(context.Background(), "27007462")
+		assert.NoError(t, err)
+		assert.Equal(t, "Different World", album.Title)
+		assert.Equal(t, 3, album.SongCount)
+		assert.Len(t, album.Songs, 3)
+
+		playlist, err := c.GetPlaylistById(context.Background(), "1141249906")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, playlist.SongCount)
+		assert.Equal(t, songs, playlist.Songs)
+
+		albums, err := c.SearchAlbums(context.Background(), "world")
+		assert.NoError(t, err)
+		assert.Len(t, albums.Albums, 1)
+	})
+
+	t.Run("with json fixture", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		path := filepath.Join(t.TempDir(), "song.json")
+		os.WriteFile(path, []byte(`{"songs":[{"id":"1xqHQw3J","title":"Faded"}]}`), 0o644)
+		assert.NoError(t, srv.LoadFixture("song.getDetails", path))
+
+		song, err := srv.Client().GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+		assert.Equal(t, "Faded", song.Title)
+	})
+
+	t.Run("with handler func", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		srv.HandleFunc("song.getDetails", func(params url.Values) (any, error) {
+			return nil, fmt.Errorf("invalid pids %s", params.Get("pids"))
+		})
+
+		_, err := srv.Client().GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+		assert.Len(t, srv.Requests(), 1)
+	})
+
+	t.Run("with injected fault", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(seedSongs(1)...)
+		srv.InjectFault("song.getDetails", jiosaavntest.Fault{StatusCode: http.StatusServiceUnavailable, Times: 1})
+
+		c := srv.Client()
+		_, err := c.GetSongById(context.Background(), "song00")
+		assert.ErrorIs(t, err, jiosaavn.ErrUnexpectedResponse)
+
+		_, err = c.GetSongById(context.Background(), "song00")
+		assert.NoError(t, err)
+	})
+
+	t.Run("with latency", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.AddSongs(seedSongs(1)...)
+		srv.SetLatency(time.Second)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := srv.Client().GetSongById(ctx, "song00")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,135 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+	"html"
+)
+
+// Label.
+type Label struct {
+	ID           string
+	Name         string
+	Image        string
+	PermanentURL string
+}
+
+// Label Info, top songs and albums are paginated.
+type LabelInfo struct {
+	Label
+	Languages  []string
+	Page       int
+	HasNext    bool
+	SongCount  int
+	AlbumCount int
+	TopSongs   []Song
+	TopAlbums  []Album
+
+	// for next
+	c             *Client
+	token         string
+	searchOptions *searchOptions
+}
+
+// Get Label API Response.
+type getLabelAPIResponse struct {
+	LabelID            string            `json:"labelId"`
+	Name               string            `json:"name"`
+	Image              string            `json:"image"`
+	PermaURL           string            `json:"perma_url"`
+	URLs               map[string]string `json:"urls"`
+	AvailableLanguages []string          `json:"availableLanguages"`
+	TopSongs           struct {
+		Songs []songAPIResponse `json:"songs"`
+		Total int               `json:"total"`
+	} `json:"topSongs"`
+	TopAlbums struct {
+		Albums []getAlbumAPIResponse `json:"albums"`
+		Total  int                   `json:"total"`
+	} `json:"topAlbums"`
+}
+
+func (res *getLabelAPIResponse) count() int {
+	return len(res.TopSongs.Songs) + len(res.TopAlbums.Albums)
+}
+
+func (res *getLabelAPIResponse) validate() error {
+	if len(res.LabelID) == 0 && len(res.Name) == 0 {
+		return fmt.Errorf("invalid label token: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getLabelAPIResponse) toLabelInfo(c *Client, token string, opts *searchOptions) (LabelInfo, error) {
+	err := res.validate()
+	if err != nil {
+		return LabelInfo{}, err
+	}
+
+	if len(res.PermaURL) == 0 {
+		res.PermaURL = res.URLs["overview"]
+	}
+
+	songs := make([]Song, 0)
+	for _, result := range res.TopSongs.Songs {
+		songs = append(songs, result.toSong())
+	}
+
+	albums := make([]Album, 0)
+	for _, result := range res.TopAlbums.Albums {
+		albums = append(albums, result.toAlbum())
+	}
+
+	languages := res.AvailableLanguages
+	if languages == nil {
+		languages = make([]string, 0)
+	}
+
+	offset := (opts.page - 1) * opts.limit
+	hasNext := (len(songs) > 0 && offset+len(songs) < res.TopSongs.Total) ||
+		(len(albums) > 0 && offset+len(albums) < res.TopAlbums.Total)
+	info := LabelInfo{
+		Label: Label{
+			ID:           res.LabelID,
+			Name:         html.UnescapeString(res.Name),
+			Image:        res.Image,
+			PermanentURL: res.PermaURL,
+		},
+		Languages:  languages,
+		Page:       opts.page,
+		HasNext:    hasNext,
+		SongCount:  res.TopSongs.Total,
+		AlbumCount: res.TopAlbums.Total,
+		TopSongs:   songs,
+		TopAlbums:  albums,
+	}
+	if !hasNext {
+		return info, nil
+	}
+
+	info.c = c
+	info.token = token
+	info.searchOptions = opts
+	return info, nil
+}
+
+// Next returns the next page of top songs and albums of the label
+func (info *LabelInfo) Next(ctx context.Context) (LabelInfo, error) {
+	if !info.HasNext {
+		return LabelInfo{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	info.searchOptions.page += 1
+	return info.c.getLabel(withOperation(ctx, "GetLabel"), info.token, info.searchOptions)
+}
+
+// labelURL returns the absolute url of a label, the api returns relative urls
+func labelURL(u string) string {
+	if len(u) > 0 && u[0] == '/' {
+		return siteURL + u
+	}
+
+	return u
+}
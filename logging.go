@@ -0,0 +1,85 @@
+package jiosaavn
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+// params whose values are never logged
+var redactedParams = []string{"auth", "cookie", "password", "secret", "session"}
+
+// WithLogger logs every call to logger.
+// Successful calls are logged at info level and failed calls at error level,
+// the debug level additionally dumps the truncated body of undecodable responses.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// loggingMiddleware logs calls, it is the innermost middleware so it sees the params sent
+func loggingMiddleware(logger *slog.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) error {
+			err := next(ctx, call)
+
+			attrs := []slog.Attr{
+				slog.String("operation", call.Operation),
+				slog.String("endpoint", call.Endpoint),
+				slog.Any("params", sanitizeParams(call.Params)),
+				slog.Int("status", call.StatusCode),
+				slog.Duration("duration", call.Latency),
+				slog.Int("bytes", call.BytesRead),
+			}
+
+			if err == nil {
+				logger.LogAttrs(ctx, slog.LevelInfo, "jiosaavn call", attrs...)
+				return nil
+			}
+
+			attrs = append(attrs, slog.String("error", err.Error()))
+			logger.LogAttrs(ctx, slog.LevelError, "jiosaavn call failed", attrs...)
+
+			var apiErr *APIError
+			if errors.Is(err, ErrUnexpectedResponse) && errors.As(err, &apiErr) {
+				logger.LogAttrs(
+					ctx,
+					slog.LevelDebug,
+					"jiosaavn undecodable response",
+					slog.String("endpoint", call.Endpoint),
+					slog.String("body", apiErr.Body),
+				)
+			}
+
+			return err
+		}
+	}
+}
+
+// sanitizeParams returns the params without the endpoint and with sensitive values redacted
+func sanitizeParams(params map[string]string) slog.Value {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k != callEndpoint {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		v := params[k]
+		for _, r := range redactedParams {
+			if strings.Contains(strings.ToLower(k), r) {
+				v = "REDACTED"
+				break
+			}
+		}
+		attrs = append(attrs, slog.String(k, v))
+	}
+
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,89 @@
+package jiosaavn
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// Lyrics.
+type Lyrics struct {
+	SongID    string
+	Text      string
+	Snippet   string
+	Copyright string
+	Writers   []string
+}
+
+// Get Lyrics API Response.
+type getLyricsAPIResponse struct {
+	Lyrics          string `json:"lyrics"`
+	LyricsCopyright string `json:"lyrics_copyright"`
+	Snippet         string `json:"snippet"`
+	Status          string `json:"status"`
+}
+
+func (res *getLyricsAPIResponse) count() int {
+	if len(res.Lyrics) == 0 {
+		return 0
+	}
+
+	return 1
+}
+
+func (res *getLyricsAPIResponse) validate() error {
+	if len(normalizeLyrics(res.Lyrics)) == 0 {
+		return fmt.Errorf("no lyrics for song id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getLyricsAPIResponse) toLyrics(songID string) (Lyrics, error) {
+	err := res.validate()
+	if err != nil {
+		return Lyrics{}, err
+	}
+
+	text := normalizeLyrics(res.Lyrics)
+
+	copyright := normalizeLyrics(res.LyricsCopyright)
+	return Lyrics{
+		SongID:    songID,
+		Text:      text,
+		Snippet:   normalizeLyrics(res.Snippet),
+		Copyright: copyright,
+		Writers:   parseWriters(copyright),
+	}, nil
+}
+
+var lineBreakRegexp = regexp.MustCompile(`(?i)<br\s*/?>`)
+
+// normalizeLyrics replaces html line breaks and entities of lyrics
+func normalizeLyrics(s string) string {
+	s = lineBreakRegexp.ReplaceAllString(s, "\n")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+// parseWriters parses the writer credits of the copyright text,
+// e.g. "Writer(s): Alan Walker, Jesper Borgen"
+func parseWriters(copyright string) []string {
+	writers := make([]string, 0)
+	for _, line := range strings.Split(copyright, "\n") {
+		_, credits, ok := strings.Cut(line, "Writer(s):")
+		if !ok {
+			continue
+		}
+
+		for _, writer := range strings.Split(credits, ",") {
+			writer = strings.TrimSpace(writer)
+			if len(writer) > 0 {
+				writers = append(writers, writer)
+			}
+		}
+	}
+
+	return writers
+}
@@ -0,0 +1,186 @@
+// Package metrics exposes Prometheus metrics of jiosaavn.Client usage.
+// It is a separate module, so only programs importing it depend on Prometheus.
+//
+//	collector := metrics.NewCollector()
+//	prometheus.MustRegister(collector)
+//	c := jiosaavn.NewClient(nil, jiosaavn.WithMiddleware(collector.Middleware()))
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"github.com/ppalone/jiosaavn"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// label names
+const (
+	endpointLabel = "endpoint"
+	kindLabel     = "kind"
+	resultLabel   = "result"
+)
+
+// error kinds
+const (
+	kindNotFound           = "not_found"
+	kindRateLimited        = "rate_limited"
+	kindInvalidArgument    = "invalid_argument"
+	kindUnexpectedResponse = "unexpected_response"
+	kindCanceled           = "canceled"
+	kindNetwork            = "network"
+	kindOther              = "other"
+)
+
+// Option
+type Option func(opts *options)
+
+type options struct {
+	namespace string
+	buckets   []float64
+}
+
+// WithNamespace sets the metric namespace, defaults to "jiosaavn"
+func WithNamespace(namespace string) Option {
+	return func(opts *options) {
+		opts.namespace = namespace
+	}
+}
+
+// WithBuckets sets the buckets of the duration histograms in seconds
+func WithBuckets(buckets []float64) Option {
+	return func(opts *options) {
+		opts.buckets = buckets
+	}
+}
+
+// Collector is a prometheus.Collector of client calls labeled by __call endpoint.
+type Collector struct {
+	calls       *prometheus.CounterVec
+	errors      *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	retries     *prometheus.CounterVec
+	cache       *prometheus.CounterVec
+	limiterWait *prometheus.HistogramVec
+}
+
+// NewCollector returns a new collector, it must be registered and added
+// to the client with Middleware
+func NewCollector(opts ...Option) *Collector {
+	o := &options{
+		namespace: "jiosaavn",
+		buckets:   prometheus.DefBuckets,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "calls_total",
+			Help:      "Number of api calls.",
+		}, []string{endpointLabel}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "call_errors_total",
+			Help:      "Number of failed api calls by error kind.",
+		}, []string{endpointLabel, kindLabel}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      "call_duration_seconds",
+			Help:      "Latency of api calls, retries included.",
+			Buckets:   o.buckets,
+		}, []string{endpointLabel}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "retries_total",
+			Help:      "Number of retried requests.",
+		}, []string{endpointLabel}),
+		cache: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: o.namespace,
+			Name:      "cache_requests_total",
+			Help:      "Number of cache lookups by result.",
+		}, []string{endpointLabel, resultLabel}),
+		limiterWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: o.namespace,
+			Name:      "rate_limiter_wait_seconds",
+			Help:      "Time spent waiting for the rate limiters.",
+			Buckets:   o.buckets,
+		}, []string{endpointLabel}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, collector := range c.collectors() {
+		collector.Describe(ch)
+	}
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	for _, collector := range c.collectors() {
+		collector.Collect(ch)
+	}
+}
+
+func (c *Collector) collectors() []prometheus.Collector {
+	return []prometheus.Collector{c.calls, c.errors, c.duration, c.retries, c.cache, c.limiterWait}
+}
+
+// Middleware returns the middleware recording calls
+func (c *Collector) Middleware() jiosaavn.Middleware {
+	return func(next jiosaavn.Handler) jiosaavn.Handler {
+		return func(ctx context.Context, call *jiosaavn.Call) error {
+			err := next(ctx, call)
+			c.observe(call, err)
+			return err
+		}
+	}
+}
+
+func (c *Collector) observe(call *jiosaavn.Call, err error) {
+	endpoint := call.Endpoint
+
+	c.calls.WithLabelValues(endpoint).Inc()
+	c.duration.WithLabelValues(endpoint).Observe(call.Latency.Seconds())
+
+	if call.Attempts > 1 {
+		c.retries.WithLabelValues(endpoint).Add(float64(call.Attempts - 1))
+	}
+
+	if len(call.CacheStatus) > 0 {
+		c.cache.WithLabelValues(endpoint, string(call.CacheStatus)).Inc()
+	}
+
+	if call.Attempts > 0 {
+		c.limiterWait.WithLabelValues(endpoint).Observe(call.LimiterWait.Seconds())
+	}
+
+	if err != nil {
+		c.errors.WithLabelValues(endpoint, errorKind(err)).Inc()
+	}
+}
+
+func errorKind(err error) string {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, jiosaavn.ErrNotFound):
+		return kindNotFound
+	case errors.Is(err, jiosaavn.ErrRateLimited):
+		return kindRateLimited
+	case errors.Is(err, jiosaavn.ErrInvalidArgument):
+		return kindInvalidArgument
+	case errors.Is(err, jiosaavn.ErrUnexpectedResponse):
+		return kindUnexpectedResponse
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return kindCanceled
+	case errors.As(err, &netErr):
+		return kindNetwork
+	default:
+		return kindOther
+	}
+}
@@ -0,0 +1,68 @@
+package metrics_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ppalone/jiosaavn"
+	"github.com/ppalone/jiosaavn/jiosaavntest"
+	"github.com/ppalone/jiosaavn/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector(t *testing.T) {
+	srv := jiosaavntest.NewServer()
+	defer srv.Close()
+	srv.AddSongs(jiosaavn.Song{ID: "1xqHQw3J", Title: "Faded"})
+
+	collector := metrics.NewCollector()
+	reg := prometheus.NewPedanticRegistry()
+	assert.NoError(t, reg.Register(collector))
+
+	c := srv.Client(
+		jiosaavn.WithMiddleware(collector.Middleware()),
+		jiosaavn.WithCache(jiosaavn.NewLRUCache(10)),
+		jiosaavn.WithRateLimiter(jiosaavn.NewTokenBucket(100, 10)),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.NoError(t, err)
+	}
+
+	_, err := c.GetAlbumById(context.Background(), "27007462")
+	assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+
+	_, err = c.GetAlbumById(context.Background(), "faded")
+	assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+	expected := `
+# HELP jiosaavn_cache_requests_total Number of cache lookups by result.
+# TYPE jiosaavn_cache_requests_total counter
+jiosaavn_cache_requests_total{endpoint="content.getAlbumDetails",result="miss"} 1
+jiosaavn_cache_requests_total{endpoint="song.getDetails",result="hit"} 2
+jiosaavn_cache_requests_total{endpoint="song.getDetails",result="miss"} 1
+# HELP jiosaavn_call_errors_total Number of failed api calls by error kind.
+# TYPE jiosaavn_call_errors_total counter
+jiosaavn_call_errors_total{endpoint="content.getAlbumDetails",kind="invalid_argument"} 1
+jiosaavn_call_errors_total{endpoint="content.getAlbumDetails",kind="not_found"} 1
+# HELP jiosaavn_calls_total Number of api calls.
+# TYPE jiosaavn_calls_total counter
+jiosaavn_calls_total{endpoint="content.getAlbumDetails"} 2
+jiosaavn_calls_total{endpoint="song.getDetails"} 3
+`
+	err = testutil.GatherAndCompare(
+		reg,
+		strings.NewReader(expected),
+		"jiosaavn_calls_total",
+		"jiosaavn_call_errors_total",
+		"jiosaavn_cache_requests_total",
+	)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "jiosaavn_call_duration_seconds"))
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "jiosaavn_rate_limiter_wait_seconds"))
+}
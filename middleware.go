@@ -0,0 +1,91 @@
+package jiosaavn
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Call is a single logical api call flowing through the middleware chain.
+type Call struct {
+	// Operation is the client method making the call, e.g. "SearchSongs".
+	Operation string
+
+	// Endpoint is the __call endpoint, e.g. "search.getResults".
+	Endpoint string
+
+	// Params are the request params, they can be modified before calling next.
+	Params map[string]string
+
+	// Header is sent with the request in addition to the client headers.
+	Header http.Header
+
+	// Result is the decoded api response, populated once next returns nil.
+	Result any
+
+	// Latency is the time spent sending the call, set once next returns.
+	Latency time.Duration
+
+	// StatusCode and BytesRead describe the last response received.
+	StatusCode int
+	BytesRead  int
+
+	// Attempts is the number of requests sent, including retries.
+	Attempts int
+
+	// ResultCount is the number of entities in the decoded response.
+	ResultCount int
+
+	// CacheStatus tells how the cache served the call, empty without a cache.
+	CacheStatus CacheStatus
+
+	// LimiterWait is the time spent waiting for the rate limiters.
+	LimiterWait time.Duration
+
+	// err rejects the call before it is sent, e.g. for invalid arguments
+	err error
+}
+
+// CacheStatus
+type CacheStatus string
+
+// cache statuses
+const (
+	CacheHit    CacheStatus = "hit"
+	CacheStale  CacheStatus = "stale"
+	CacheMiss   CacheStatus = "miss"
+	CacheBypass CacheStatus = "bypass"
+)
+
+// counter is implemented by api responses holding a list of entities
+type counter interface {
+	count() int
+}
+
+// validator is implemented by api responses that decode without error but may
+// still be unusable, e.g. empty for an unknown id
+type validator interface {
+	validate() error
+}
+
+// Handler handles a call.
+type Handler func(ctx context.Context, call *Call) error
+
+// Middleware wraps a Handler to observe or modify calls.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares to the client, the first one being the outermost
+func WithMiddleware(mw ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, mw...)
+	}
+}
+
+// chain wraps h with the middlewares, the first one being the outermost
+func chain(h Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+
+	return h
+}
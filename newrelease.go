@@ -0,0 +1,69 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+)
+
+// New Releases Results
+type NewReleasesResults struct {
+	Page    int
+	Size    int
+	Total   int
+	HasNext bool
+	Albums  []Album
+
+	// for next
+	c             *Client
+	searchOptions *searchOptions
+}
+
+// Get Albums API Response.
+type getAlbumsAPIResponse struct {
+	Data     []getAlbumAPIResponse `json:"data"`
+	Count    int                   `json:"count"`
+	LastPage bool                  `json:"last_page"`
+}
+
+func (res *getAlbumsAPIResponse) count() int {
+	return len(res.Data)
+}
+
+func (res *getAlbumsAPIResponse) toResults(c *Client, opts *searchOptions) (NewReleasesResults, error) {
+	albums := make([]Album, 0)
+
+	for _, result := range res.Data {
+		albums = append(albums, result.toAlbum())
+	}
+
+	hasNext := len(albums) > 0 && !res.LastPage
+	if !hasNext {
+		return NewReleasesResults{
+			Page:    opts.page,
+			Size:    len(albums),
+			Total:   res.Count,
+			HasNext: hasNext,
+			Albums:  albums,
+		}, nil
+	}
+
+	return NewReleasesResults{
+		Page:          opts.page,
+		Size:          len(albums),
+		Total:         res.Count,
+		HasNext:       hasNext,
+		Albums:        albums,
+		c:             c,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *NewReleasesResults) Next(ctx context.Context) (NewReleasesResults, error) {
+	if !results.HasNext {
+		return NewReleasesResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.getNewReleases(ctx, results.searchOptions)
+}
@@ -20,9 +20,11 @@ type Playlist struct {
 // Playlist Info.
 type PlaylistInfo struct {
 	Playlist
-	PlayCount int
-	Songs     []Song
-	Artists   []Artist
+	PlayCount     int
+	FollowerCount int
+	Owner         User
+	Songs         []Song
+	Artists       []Artist
 }
 
 // Get Playlist API Response.
@@ -61,13 +63,27 @@ type getPlaylistAPIResponse struct {
 	} `json:"more_info"`
 }
 
-func (res *getPlaylistAPIResponse) toPlaylistInfo() (PlaylistInfo, error) {
+func (res *getPlaylistAPIResponse) count() int {
+	return len(res.List)
+}
+
+func (res *getPlaylistAPIResponse) validate() error {
 	if len(res.Title) == 0 && len(res.List) == 0 {
-		return PlaylistInfo{}, fmt.Errorf("invalid playlist id")
+		return fmt.Errorf("invalid playlist id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getPlaylistAPIResponse) toPlaylistInfo() (PlaylistInfo, error) {
+	err := res.validate()
+	if err != nil {
+		return PlaylistInfo{}, err
 	}
 
 	songCount, _ := strconv.Atoi(res.ListCount)
 	playCount, _ := strconv.Atoi(res.PlayCount)
+	followerCount, _ := strconv.Atoi(res.MoreInfo.FollowerCount)
 	playlist := Playlist{
 		ID:              res.ID,
 		Title:           html.UnescapeString(res.Title),
@@ -79,8 +95,15 @@ func (res *getPlaylistAPIResponse) toPlaylistInfo() (PlaylistInfo, error) {
 	}
 
 	playlistInfo := PlaylistInfo{
-		PlayCount: playCount,
-		Playlist:  playlist,
+		PlayCount:     playCount,
+		FollowerCount: followerCount,
+		Playlist:      playlist,
+		Owner: User{
+			ID:        res.MoreInfo.UID,
+			Username:  res.MoreInfo.Username,
+			FirstName: html.UnescapeString(res.MoreInfo.Firstname),
+			LastName:  html.UnescapeString(res.MoreInfo.Lastname),
+		},
 	}
 
 	songs := make([]Song, 0)
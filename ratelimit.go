@@ -0,0 +1,126 @@
+package jiosaavn
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter blocks until a request is allowed to be sent.
+// Implementations must be safe for concurrent use.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// TokenBucket is an in-process token bucket Limiter.
+type TokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a limiter allowing rate requests per second with bursts of up to burst requests
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available or the context is done
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token, going into debt if needed, and returns how long to wait for it
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if b.rate > 0 {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	if b.rate <= 0 {
+		// no refill, wait forever
+		return time.Duration(1<<63 - 1)
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token which was reserved but not used
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.burst, b.tokens+1)
+}
+
+// WithRateLimiter sets the limiter shared by all requests of the client
+func WithRateLimiter(l Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = l
+	}
+}
+
+// WithEndpointRateLimiter sets a limiter for requests to the given __call endpoint,
+// e.g. "search.getResults". It is applied in addition to the client wide limiter.
+func WithEndpointRateLimiter(endpoint string, l Limiter) ClientOption {
+	return func(c *Client) {
+		if c.endpointLimiters == nil {
+			c.endpointLimiters = make(map[string]Limiter)
+		}
+		c.endpointLimiters[endpoint] = l
+	}
+}
+
+// wait blocks until the limiters allow a request to the endpoint
+func (c *Client) wait(ctx context.Context, endpoint string) error {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if l, ok := c.endpointLimiters[endpoint]; ok && l != nil {
+		if err := l.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package jiosaavn
+
+import "strings"
+
+// Get Recommendations API Response.
+type getRecommendationsAPIResponse []songAPIResponse
+
+func (res *getRecommendationsAPIResponse) count() int {
+	return len(*res)
+}
+
+func (res *getRecommendationsAPIResponse) toSongs(opts *searchOptions) []Song {
+	songs := make([]Song, 0)
+	for _, result := range *res {
+		song := result.toSong()
+		if !opts.explicit && song.ExplicitContent {
+			continue
+		}
+
+		if !matchesLanguage(song.Language, opts.languages) {
+			continue
+		}
+
+		songs = append(songs, song)
+	}
+
+	return songs
+}
+
+// matchesLanguage reports whether language is one of languages,
+// an empty list matches every language
+func matchesLanguage(language string, languages []string) bool {
+	if len(languages) == 0 {
+		return true
+	}
+
+	for _, l := range languages {
+		if strings.EqualFold(strings.TrimSpace(l), language) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,241 @@
+// Package recorder provides a record/replay http.RoundTripper
+// to run clients against recorded JioSaavn responses.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// value stored in place of sanitized params
+const redacted = "REDACTED"
+
+// Mode
+type Mode int
+
+const (
+	// ModeReplay serves recorded responses and fails on unknown requests.
+	ModeReplay Mode = iota
+	// ModeRecord sends every request and overwrites the cassette on Stop.
+	ModeRecord
+	// ModeReplayOrRecord serves recorded responses and records unknown requests.
+	ModeReplayOrRecord
+)
+
+// Cassette is the on-disk set of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// Response
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Option
+type Option func(r *Recorder)
+
+// WithTransport sets the transport used to send requests while recording
+func WithTransport(rt http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = rt
+	}
+}
+
+// WithSanitizedParams redacts the values of the given query params in the cassette
+func WithSanitizedParams(keys ...string) Option {
+	return func(r *Recorder) {
+		r.sanitized = append(r.sanitized, keys...)
+	}
+}
+
+// Recorder is an http.RoundTripper recording and replaying interactions.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	sanitized []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	replayed map[string]int
+	changed  bool
+}
+
+// New returns a recorder backed by the cassette file at path
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		cassette:  new(Cassette),
+		replayed:  make(map[string]int),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, r.cassette); err != nil {
+		return nil, fmt.Errorf("recorder: invalid cassette %s: %w", path, err)
+	}
+
+	return r, nil
+}
+
+// RoundTrip serves the request from the cassette or records it depending on the mode
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := Request{Method: req.Method, URL: r.sanitize(req.URL)}
+
+	if r.mode != ModeRecord {
+		if res, ok := r.replay(key); ok {
+			return res.toHTTPResponse(req), nil
+		}
+
+		if r.mode == ModeReplay {
+			return nil, fmt.Errorf("recorder: no interaction recorded for %s %s in %s", key.Method, key.URL, r.path)
+		}
+	}
+
+	return r.record(req, key)
+}
+
+// Stop writes recorded interactions to the cassette file
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.changed {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, data, 0o644)
+}
+
+// replay returns the recorded responses of a request in order, repeating the last one
+func (r *Recorder) replay(key Request) (Response, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	matches := make([]Response, 0)
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Request == key {
+			matches = append(matches, interaction.Response)
+		}
+	}
+
+	if len(matches) == 0 {
+		return Response{}, false
+	}
+
+	id := key.Method + " " + key.URL
+	i := min(r.replayed[id], len(matches)-1)
+	r.replayed[id]++
+
+	return matches[i], true
+}
+
+func (r *Recorder) record(req *http.Request, key Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie")
+
+	res := Response{
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       string(body),
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{Request: key, Response: res})
+	r.changed = true
+	r.mu.Unlock()
+
+	return res.toHTTPResponse(req), nil
+}
+
+// sanitize returns the url with sanitized params redacted and the query sorted
+func (r *Recorder) sanitize(u *url.URL) string {
+	sanitized := *u
+	q := sanitized.Query()
+	for _, key := range r.sanitized {
+		if q.Has(key) {
+			q.Set(key, redacted)
+		}
+	}
+	sanitized.RawQuery = q.Encode()
+
+	return sanitized.String()
+}
+
+func (res Response) toHTTPResponse(req *http.Request) *http.Response {
+	header := res.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewBufferString(res.Body)),
+		ContentLength: int64(len(res.Body)),
+		Request:       req,
+	}
+}
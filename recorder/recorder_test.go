@@ -0,0 +1,119 @@
+package recorder_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ppalone/jiosaavn/recorder"
+	"github.com/stretchr/testify/assert"
+)
+
+func get(t *testing.T, c *http.Client, u string) (int, string) {
+	t.Helper()
+
+	resp, err := c.Get(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return resp.StatusCode, string(body)
+}
+
+func TestRecorder(t *testing.T) {
+	t.Run("with record and replay", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Set-Cookie", "session=secret")
+			w.Write([]byte(r.URL.Query().Get("q")))
+		}))
+		defer srv.Close()
+
+		path := filepath.Join(t.TempDir(), "cassettes", "search.json")
+		rec, err := recorder.New(path, recorder.ModeRecord, recorder.WithSanitizedParams("token"))
+		assert.NoError(t, err)
+
+		status, body := get(t, &http.Client{Transport: rec}, srv.URL+"?q=faded&token=abc")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "faded", body)
+		assert.NoError(t, rec.Stop())
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "abc")
+		assert.NotContains(t, string(data), "secret")
+
+		rep, err := recorder.New(path, recorder.ModeReplay, recorder.WithSanitizedParams("token"))
+		assert.NoError(t, err)
+
+		status, body = get(t, &http.Client{Transport: rep}, srv.URL+"?token=xyz&q=faded")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "faded", body)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("with unknown request in replay mode", func(t *testing.T) {
+		rep, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), recorder.ModeReplay)
+		assert.NoError(t, err)
+
+		_, err = (&http.Client{Transport: rep}).Get("https://www.jiosaavn.com/api.php?q=faded")
+		assert.ErrorContains(t, err, "no interaction recorded")
+	})
+
+	t.Run("with repeated requests", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(strings.Repeat("x", int(calls.Add(1)))))
+		}))
+		defer srv.Close()
+
+		path := filepath.Join(t.TempDir(), "repeated.json")
+		rec, err := recorder.New(path, recorder.ModeRecord)
+		assert.NoError(t, err)
+		c := &http.Client{Transport: rec}
+		get(t, c, srv.URL)
+		get(t, c, srv.URL)
+		assert.NoError(t, rec.Stop())
+
+		rep, err := recorder.New(path, recorder.ModeReplay)
+		assert.NoError(t, err)
+		c = &http.Client{Transport: rep}
+		_, first := get(t, c, srv.URL)
+		_, second := get(t, c, srv.URL)
+		_, third := get(t, c, srv.URL)
+		assert.Equal(t, "x", first)
+		assert.Equal(t, "xx", second)
+		assert.Equal(t, "xx", third)
+	})
+
+	t.Run("with replay or record mode", func(t *testing.T) {
+		var calls atomic.Int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Write([]byte("ok"))
+		}))
+		defer srv.Close()
+
+		path := filepath.Join(t.TempDir(), "mixed.json")
+		for i := 0; i < 2; i++ {
+			rec, err := recorder.New(path, recorder.ModeReplayOrRecord)
+			assert.NoError(t, err)
+			_, body := get(t, &http.Client{Transport: rec}, srv.URL)
+			assert.Equal(t, "ok", body)
+			assert.NoError(t, rec.Stop())
+		}
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
@@ -0,0 +1,86 @@
+package jiosaavn
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Link Type
+type LinkType string
+
+// link types
+const (
+	LinkSong     LinkType = "song"
+	LinkAlbum    LinkType = "album"
+	LinkPlaylist LinkType = "playlist"
+	LinkArtist   LinkType = "artist"
+	LinkShow     LinkType = "show"
+	LinkLabel    LinkType = "label"
+)
+
+// Link is a parsed JioSaavn share or perma url.
+type Link struct {
+	Type  LinkType
+	Slug  string
+	Token string
+}
+
+// Resolved is the entity of a resolved url,
+// only the field matching Type is set.
+type Resolved struct {
+	Type     LinkType
+	Song     *Song
+	Album    *AlbumInfo
+	Playlist *PlaylistInfo
+	Artist   *ArtistInfo
+	Show     *ShowInfo
+	Label    *LabelInfo
+}
+
+// ParseURL classifies a JioSaavn url, e.g. "https://www.jiosaavn.com/song/faded/IlkOBzVWZWU".
+// It doesn't make any request.
+func ParseURL(rawURL string) (Link, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return Link{}, fmt.Errorf("invalid url: %w", ErrInvalidArgument)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host != "jiosaavn.com" && !strings.HasSuffix(host, ".jiosaavn.com") {
+		return Link{}, fmt.Errorf("not a jiosaavn url: %w", ErrInvalidArgument)
+	}
+
+	segments := make([]string, 0)
+	for _, segment := range strings.Split(u.Path, "/") {
+		if len(segment) > 0 {
+			segments = append(segments, segment)
+		}
+	}
+
+	switch {
+	case len(segments) == 3 && segments[0] == "song":
+		return Link{Type: LinkSong, Slug: segments[1], Token: segments[2]}, nil
+	case len(segments) == 3 && segments[0] == "album":
+		return Link{Type: LinkAlbum, Slug: segments[1], Token: segments[2]}, nil
+	case len(segments) == 3 && segments[0] == "featured":
+		return Link{Type: LinkPlaylist, Slug: segments[1], Token: segments[2]}, nil
+	case len(segments) == 5 && segments[0] == "s" && segments[1] == "playlist":
+		// user playlists, e.g. /s/playlist/<user>/<slug>/<token>
+		return Link{Type: LinkPlaylist, Slug: segments[3], Token: segments[4]}, nil
+	case len(segments) == 3 && segments[0] == "artist":
+		return Link{Type: LinkArtist, Slug: segments[1], Token: segments[2]}, nil
+	case len(segments) == 3 && segments[0] == "label":
+		return Link{Type: LinkLabel, Slug: segments[1], Token: segments[2]}, nil
+	case (len(segments) == 3 || len(segments) == 4) && segments[0] == "shows":
+		// shows may include the season, e.g. /shows/<slug>/<season>/<token>
+		return Link{Type: LinkShow, Slug: segments[1], Token: segments[len(segments)-1]}, nil
+	}
+
+	return Link{}, fmt.Errorf("unsupported jiosaavn url: %w", ErrInvalidArgument)
+}
@@ -0,0 +1,120 @@
+package jiosaavn
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// In-band error payload returned by JioSaavn with a successful status code,
+// e.g. {"error":{"code":"INPUT_INVALID","msg":"Invalid song id"}}
+type errorAPIResponse struct {
+	Error json.RawMessage `json:"error"`
+}
+
+type errorDetailsAPIResponse struct {
+	Code json.RawMessage `json:"code"`
+	Msg  string          `json:"msg"`
+}
+
+// decodeResponse validates the raw response and unmarshals it into v
+func decodeResponse(statusCode int, params map[string]string, body []byte, v any) error {
+	trimmed := bytes.TrimSpace(body)
+
+	// html maintenance pages, plain text errors etc.
+	if len(trimmed) == 0 || (trimmed[0] != '{' && trimmed[0] != '[') {
+		err := statusError(statusCode)
+		if err == nil {
+			err = fmt.Errorf("%w: response is not json", ErrUnexpectedResponse)
+		}
+		return newAPIError(statusCode, params, body, err)
+	}
+
+	if code, msg, ok := parseErrorPayload(trimmed); ok {
+		err := statusError(statusCode)
+		if err == nil {
+			err = inBandError(code, msg)
+		}
+		apiErr := newAPIError(statusCode, params, body, err)
+		apiErr.Code = code
+		apiErr.Message = msg
+		return apiErr
+	}
+
+	if err := statusError(statusCode); err != nil {
+		return newAPIError(statusCode, params, body, err)
+	}
+
+	// an empty array is returned in place of an object for unknown entities
+	if bytes.Equal(trimmed, []byte("[]")) && !expectsList(v) {
+		return newAPIError(statusCode, params, body, ErrNotFound)
+	}
+
+	if err := json.Unmarshal(trimmed, v); err != nil {
+		return newAPIError(statusCode, params, body, fmt.Errorf("%w: %v", ErrUnexpectedResponse, err))
+	}
+
+	return nil
+}
+
+// parseErrorPayload returns the upstream error code and message of an in-band error payload
+func parseErrorPayload(body []byte) (string, string, bool) {
+	if body[0] != '{' {
+		return "", "", false
+	}
+
+	payload := new(errorAPIResponse)
+	if err := json.Unmarshal(body, payload); err != nil {
+		return "", "", false
+	}
+
+	raw := bytes.TrimSpace(payload.Error)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", "", false
+	}
+
+	// error can be a bare message
+	var msg string
+	if err := json.Unmarshal(raw, &msg); err == nil {
+		return "", msg, len(msg) > 0
+	}
+
+	details := new(errorDetailsAPIResponse)
+	if err := json.Unmarshal(raw, details); err != nil {
+		return "", "", false
+	}
+
+	// code can be either a string or a number
+	code := strings.Trim(string(bytes.TrimSpace(details.Code)), `"`)
+	if code == "null" {
+		code = ""
+	}
+
+	return code, details.Msg, len(code) > 0 || len(details.Msg) > 0
+}
+
+// inBandError maps an upstream error code and message to a sentinel error
+func inBandError(code, msg string) error {
+	s := strings.ToLower(code + " " + msg)
+	switch {
+	case strings.Contains(s, "not found") || strings.Contains(s, "not_found") || strings.Contains(s, "does not exist"):
+		return ErrNotFound
+	case strings.Contains(s, "rate") && strings.Contains(s, "limit"):
+		return ErrRateLimited
+	case strings.Contains(s, "invalid") || strings.Contains(s, "missing"):
+		return ErrInvalidArgument
+	default:
+		return ErrUnexpectedResponse
+	}
+}
+
+func expectsList(v any) bool {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array)
+}
@@ -0,0 +1,169 @@
+package jiosaavn
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// default retry policy values
+const (
+	defaultInitialBackoff = 200 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+	defaultMultiplier     = 2
+)
+
+// RetryPolicy controls how failed requests are retried.
+// Requests are retried on connection errors, 5xx responses and rate limiting,
+// other errors, e.g. of a Limiter, are returned as is.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the exponential backoff. A Retry-After sent by
+	// JioSaavn is honored even when it is longer.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the backoff after every attempt.
+	Multiplier float64
+
+	// Jitter is the fraction [0, 1] of the backoff that is randomized.
+	Jitter float64
+
+	// OnRetry is called before waiting for the next attempt.
+	OnRetry func(RetryEvent)
+}
+
+// RetryEvent describes a failed attempt which is about to be retried.
+type RetryEvent struct {
+	Endpoint string
+	Attempt  int
+	Err      error
+	Delay    time.Duration
+}
+
+// DefaultRetryPolicy returns a retry policy with sensible defaults
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: defaultInitialBackoff,
+		MaxBackoff:     defaultMaxBackoff,
+		Multiplier:     defaultMultiplier,
+		Jitter:         0.2,
+	}
+}
+
+// WithRetryPolicy enables retries of failed requests
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		if policy.InitialBackoff <= 0 {
+			policy.InitialBackoff = defaultInitialBackoff
+		}
+
+		if policy.MaxBackoff <= 0 {
+			policy.MaxBackoff = defaultMaxBackoff
+		}
+
+		if policy.Multiplier < 1 {
+			policy.Multiplier = defaultMultiplier
+		}
+
+		policy.Jitter = math.Min(math.Max(policy.Jitter, 0), 1)
+		c.retryPolicy = policy
+	}
+}
+
+// retry calls fn until it succeeds, the error is not retryable or the attempts are exhausted
+func (c *Client) retry(ctx context.Context, params map[string]string, fn func() error) error {
+	policy := c.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= policy.MaxAttempts || !isRetryable(ctx, err) {
+			return err
+		}
+
+		delay := policy.backoff(attempt)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+			delay = apiErr.RetryAfter
+		}
+
+		if policy.OnRetry != nil {
+			policy.OnRetry(RetryEvent{
+				Endpoint: params[callEndpoint],
+				Attempt:  attempt,
+				Err:      err,
+				Delay:    delay,
+			})
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the delay after the given attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	d = math.Min(d, float64(p.MaxBackoff))
+	d -= d * p.Jitter * rand.Float64()
+
+	return time.Duration(d)
+}
+
+func isRetryable(ctx context.Context, err error) bool {
+	// caller gave up
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return errors.Is(err, ErrRateLimited) || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// connection errors, the http client wraps them in a url.Error as it does invalid urls
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// parseRetryAfter parses the Retry-After header, either delay seconds or a http date
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+
+	if t, err := http.ParseTime(value); err == nil {
+		return max(time.Until(t), 0)
+	}
+
+	return 0
+}
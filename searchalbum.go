@@ -25,6 +25,10 @@ type searchAlbumAPIResponse struct {
 	Results []getAlbumAPIResponse `json:"results"`
 }
 
+func (res *searchAlbumAPIResponse) count() int {
+	return len(res.Results)
+}
+
 func (res *searchAlbumAPIResponse) toResults(c *Client, opts *searchOptions) (SearchAlbumsResults, error) {
 	albums := make([]Album, 0)
 
@@ -50,6 +50,10 @@ func (res *artistAPIResponse) toArtist() Artist {
 	}
 }
 
+func (resp *searchArtistsAPIResponse) count() int {
+	return len(resp.Results)
+}
+
 func (resp *searchArtistsAPIResponse) toResults(c *Client, opts *searchOptions) (SearchArtistsResults, error) {
 	artists := make([]Artist, 0)
 
@@ -5,20 +5,91 @@ import "fmt"
 // Search Option
 type SearchOption func(opts *searchOptions)
 
+// Sort Order
+type SortOrder string
+
+// sort orders
+const (
+	SortByPopularity   SortOrder = "popularity"
+	SortByLatest       SortOrder = "latest"
+	SortByAlphabetical SortOrder = "alphabetical"
+)
+
+// optionKind identifies a search option, to reject options a method ignores
+type optionKind uint8
+
+const (
+	pageOption optionKind = 1 << iota
+	limitOption
+	sortOrderOption
+	languagesOption
+	explicitOption
+
+	paginationOptions = pageOption | limitOption
+)
+
+var optionNames = map[optionKind]string{
+	pageOption:      "WithPage",
+	limitOption:     "WithLimit",
+	sortOrderOption: "WithSortOrder",
+	languagesOption: "WithLanguages",
+	explicitOption:  "WithoutExplicitContent",
+}
+
 // Search Options
 type searchOptions struct {
-	page  int
-	limit int
-	query string
+	page      int
+	limit     int
+	query     string
+	sortOrder SortOrder
+	languages []string
+	explicit  bool
+	applied   optionKind
+}
+
+// allow returns an error if an option other than the allowed ones was applied
+func (o *searchOptions) allow(operation string, allowed optionKind) error {
+	for kind := pageOption; kind <= explicitOption; kind <<= 1 {
+		if o.applied&kind != 0 && allowed&kind == 0 {
+			return fmt.Errorf("%s doesn't apply to %s: %w", optionNames[kind], operation, ErrInvalidArgument)
+		}
+	}
+
+	return nil
 }
 
 func (o *searchOptions) validate() error {
 	if len(o.query) == 0 {
-		return fmt.Errorf("search query cannot be empty")
+		return fmt.Errorf("search query cannot be empty: %w", ErrInvalidArgument)
 	}
 
+	return o.validateLimit()
+}
+
+// validateLimit validates the limit, shared by searches and paginated listings
+func (o *searchOptions) validateLimit() error {
 	if o.limit < 10 || o.limit > 40 {
-		return fmt.Errorf("limit must be between 10 and 40")
+		return fmt.Errorf("limit must be between 10 and 40: %w", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// validatePagination validates the options of paginated listings
+func (o *searchOptions) validatePagination() error {
+	if o.page < 1 {
+		return fmt.Errorf("page must be greater than 0: %w", ErrInvalidArgument)
+	}
+
+	err := o.validateLimit()
+	if err != nil {
+		return err
+	}
+
+	switch o.sortOrder {
+	case SortByPopularity, SortByLatest, SortByAlphabetical:
+	default:
+		return fmt.Errorf("unknown sort order %q: %w", o.sortOrder, ErrInvalidArgument)
 	}
 
 	return nil
@@ -27,8 +98,10 @@ func (o *searchOptions) validate() error {
 // defaultSearchOpts returns the default search options
 func defaultSearchOpts() *searchOptions {
 	return &searchOptions{
-		page:  1,
-		limit: 10,
+		page:      1,
+		limit:     10,
+		sortOrder: SortByPopularity,
+		explicit:  true,
 	}
 }
 
@@ -36,6 +109,7 @@ func defaultSearchOpts() *searchOptions {
 func WithPage(page int) SearchOption {
 	return func(opts *searchOptions) {
 		opts.page = page
+		opts.applied |= pageOption
 	}
 }
 
@@ -43,5 +117,30 @@ func WithPage(page int) SearchOption {
 func WithLimit(limit int) SearchOption {
 	return func(opts *searchOptions) {
 		opts.limit = limit
+		opts.applied |= limitOption
+	}
+}
+
+// WithSortOrder sets the sort order of artist, label and episode listings
+func WithSortOrder(order SortOrder) SearchOption {
+	return func(opts *searchOptions) {
+		opts.sortOrder = order
+		opts.applied |= sortOrderOption
+	}
+}
+
+// WithLanguages restricts results to the given languages, e.g. "english", "hindi"
+func WithLanguages(languages ...string) SearchOption {
+	return func(opts *searchOptions) {
+		opts.languages = languages
+		opts.applied |= languagesOption
+	}
+}
+
+// WithoutExplicitContent excludes explicit songs from results
+func WithoutExplicitContent() SearchOption {
+	return func(opts *searchOptions) {
+		opts.explicit = false
+		opts.applied |= explicitOption
 	}
 }
@@ -21,45 +21,61 @@ type SearchPlaylistsResults struct {
 
 // Search playlists API Response.
 type searchPlaylistsAPIResponse struct {
-	Total   int `json:"total"`
-	Start   int `json:"start"`
-	Results []struct {
-		ID       string `json:"id"`
-		Title    string `json:"title"`
-		Subtitle string `json:"subtitle"`
-		Type     string `json:"type"`
-		Image    string `json:"image"`
-		PermaURL string `json:"perma_url"`
-		MoreInfo struct {
-			UID            string `json:"uid"`
-			Firstname      string `json:"firstname"`
-			EntityType     string `json:"entity_type"`
-			EntitySubType  string `json:"entity_sub_type"`
-			VideoAvailable bool   `json:"video_available"`
-			Lastname       string `json:"lastname"`
-			SongCount      string `json:"song_count"`
-			Language       string `json:"language"`
-		} `json:"more_info"`
-		ExplicitContent string `json:"explicit_content"`
-		MiniObj         bool   `json:"mini_obj"`
-	} `json:"results"`
+	Total   int                   `json:"total"`
+	Start   int                   `json:"start"`
+	Results []playlistAPIResponse `json:"results"`
+}
+
+// Playlist API Response.
+type playlistAPIResponse struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Type     string `json:"type"`
+	Image    string `json:"image"`
+	PermaURL string `json:"perma_url"`
+	Language string `json:"language"`
+	MoreInfo struct {
+		UID            string `json:"uid"`
+		Firstname      string `json:"firstname"`
+		EntityType     string `json:"entity_type"`
+		EntitySubType  string `json:"entity_sub_type"`
+		VideoAvailable bool   `json:"video_available"`
+		Lastname       string `json:"lastname"`
+		SongCount      string `json:"song_count"`
+		Language       string `json:"language"`
+	} `json:"more_info"`
+	ExplicitContent string `json:"explicit_content"`
+	MiniObj         bool   `json:"mini_obj"`
+}
+
+func (res *playlistAPIResponse) toPlaylist() Playlist {
+	count, _ := strconv.Atoi(res.MoreInfo.SongCount)
+	language := res.MoreInfo.Language
+	if len(language) == 0 {
+		language = res.Language
+	}
+
+	return Playlist{
+		ID:              res.ID,
+		Title:           res.Title,
+		Image:           res.Image,
+		PermanentURL:    res.PermaURL,
+		SongCount:       count,
+		Language:        language,
+		ExplicitContent: res.ExplicitContent == "1",
+	}
+}
+
+func (resp *searchPlaylistsAPIResponse) count() int {
+	return len(resp.Results)
 }
 
 func (resp *searchPlaylistsAPIResponse) toResults(c *Client, opts *searchOptions) (SearchPlaylistsResults, error) {
 	playlists := make([]Playlist, 0)
 
 	for _, result := range resp.Results {
-		count, _ := strconv.Atoi(result.MoreInfo.SongCount)
-
-		playlists = append(playlists, Playlist{
-			ID:              result.ID,
-			Title:           result.Title,
-			Image:           result.Image,
-			PermanentURL:    result.PermaURL,
-			SongCount:       count,
-			Language:        result.MoreInfo.Language,
-			ExplicitContent: result.ExplicitContent == "1",
-		})
+		playlists = append(playlists, result.toPlaylist())
 	}
 
 	hasNext := ((resp.Start - 1) + len(resp.Results)) < resp.Total
@@ -0,0 +1,69 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+)
+
+// Search Podcasts Results
+type SearchPodcastsResults struct {
+	Page    int
+	Size    int
+	Total   int
+	HasNext bool
+	Shows   []Show
+
+	// for next
+	c             *Client
+	searchOptions *searchOptions
+}
+
+// Search Podcasts API Response.
+type searchPodcastsAPIResponse struct {
+	Total   int               `json:"total"`
+	Start   int               `json:"start"`
+	Results []showAPIResponse `json:"results"`
+}
+
+func (res *searchPodcastsAPIResponse) count() int {
+	return len(res.Results)
+}
+
+func (res *searchPodcastsAPIResponse) toResults(c *Client, opts *searchOptions) (SearchPodcastsResults, error) {
+	shows := make([]Show, 0)
+
+	for _, result := range res.Results {
+		shows = append(shows, result.toShow())
+	}
+
+	hasNext := ((res.Start - 1) + len(res.Results)) < res.Total
+	if !hasNext {
+		return SearchPodcastsResults{
+			Page:    opts.page,
+			Size:    len(shows),
+			Total:   res.Total,
+			Shows:   shows,
+			HasNext: hasNext,
+		}, nil
+	}
+
+	return SearchPodcastsResults{
+		Page:          opts.page,
+		Size:          len(shows),
+		Total:         res.Total,
+		Shows:         shows,
+		HasNext:       hasNext,
+		c:             c,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *SearchPodcastsResults) Next(ctx context.Context) (SearchPodcastsResults, error) {
+	if !results.HasNext {
+		return SearchPodcastsResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.searchPodcasts(ctx, results.searchOptions.query, results.searchOptions)
+}
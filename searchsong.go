@@ -25,6 +25,10 @@ type searchSongsAPIResponse struct {
 	Results []songAPIResponse `json:"results"`
 }
 
+func (resp *searchSongsAPIResponse) count() int {
+	return len(resp.Results)
+}
+
 func (resp *searchSongsAPIResponse) toResults(c *Client, opts *searchOptions) (SearchSongsResults, error) {
 	songs := make([]Song, 0)
 
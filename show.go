@@ -0,0 +1,156 @@
+package jiosaavn
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+)
+
+// Show.
+type Show struct {
+	ID           string
+	Title        string
+	Subtitle     string
+	PermanentURL string
+	Image        string
+	Language     string
+	SeasonNumber int
+}
+
+// Show Info
+type ShowInfo struct {
+	Show
+	Description  string
+	EpisodeCount int
+	Seasons      []Season
+	Episodes     []Episode
+}
+
+// Season of a show.
+type Season struct {
+	ID           string
+	Title        string
+	Image        string
+	PermanentURL string
+	Number       int
+	EpisodeCount int
+}
+
+// Show API Response.
+type showAPIResponse struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle"`
+	Type     string `json:"type"`
+	Image    string `json:"image"`
+	PermaURL string `json:"perma_url"`
+	Language string `json:"language"`
+	MoreInfo struct {
+		SeasonNumber string `json:"season_number"`
+		Language     string `json:"language"`
+		Description  string `json:"description"`
+		EpisodeCount string `json:"total_episodes"`
+	} `json:"more_info"`
+}
+
+// Season API Response.
+type seasonAPIResponse struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Image    string `json:"image"`
+	PermaURL string `json:"perma_url"`
+	MoreInfo struct {
+		SeasonNumber string `json:"season_number"`
+		NumEpisodes  string `json:"numEpisodes"`
+	} `json:"more_info"`
+}
+
+func (res *seasonAPIResponse) toSeason() Season {
+	number, _ := strconv.Atoi(res.MoreInfo.SeasonNumber)
+	episodeCount, _ := strconv.Atoi(res.MoreInfo.NumEpisodes)
+
+	return Season{
+		ID:           res.ID,
+		Title:        html.UnescapeString(res.Title),
+		Image:        res.Image,
+		PermanentURL: res.PermaURL,
+		Number:       number,
+		EpisodeCount: episodeCount,
+	}
+}
+
+func (res *showAPIResponse) toShow() Show {
+	seasonNumber, _ := strconv.Atoi(res.MoreInfo.SeasonNumber)
+	language := res.Language
+	if len(language) == 0 {
+		language = res.MoreInfo.Language
+	}
+
+	return Show{
+		ID:           res.ID,
+		Title:        html.UnescapeString(res.Title),
+		Subtitle:     html.UnescapeString(res.Subtitle),
+		PermanentURL: res.PermaURL,
+		Image:        res.Image,
+		Language:     language,
+		SeasonNumber: seasonNumber,
+	}
+}
+
+// Get Show API Response.
+type getShowAPIResponse struct {
+	ShowDetails showAPIResponse      `json:"show_details"`
+	Seasons     []seasonAPIResponse  `json:"seasons"`
+	Episodes    []episodeAPIResponse `json:"episodes"`
+}
+
+func (res *getShowAPIResponse) count() int {
+	if len(res.ShowDetails.ID) == 0 {
+		return 0
+	}
+
+	return 1
+}
+
+func (res *getShowAPIResponse) validate() error {
+	if len(res.ShowDetails.ID) == 0 {
+		return fmt.Errorf("invalid show id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getShowAPIResponse) toShow() (Show, error) {
+	err := res.validate()
+	if err != nil {
+		return Show{}, err
+	}
+
+	return res.ShowDetails.toShow(), nil
+}
+
+func (res *getShowAPIResponse) toShowInfo() (ShowInfo, error) {
+	show, err := res.toShow()
+	if err != nil {
+		return ShowInfo{}, err
+	}
+
+	seasons := make([]Season, 0)
+	for _, season := range res.Seasons {
+		seasons = append(seasons, season.toSeason())
+	}
+
+	episodes := make([]Episode, 0)
+	for _, episode := range res.Episodes {
+		episodes = append(episodes, episode.toEpisode())
+	}
+
+	episodeCount, _ := strconv.Atoi(res.ShowDetails.MoreInfo.EpisodeCount)
+	return ShowInfo{
+		Show:         show,
+		Description:  html.UnescapeString(res.ShowDetails.MoreInfo.Description),
+		EpisodeCount: episodeCount,
+		Seasons:      seasons,
+		Episodes:     episodes,
+	}, nil
+}
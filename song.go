@@ -3,6 +3,7 @@ package jiosaavn
 import (
 	"encoding/json"
 	"fmt"
+	"html"
 	"strconv"
 )
 
@@ -22,12 +23,27 @@ type Song struct {
 	AlbumName       string
 	AlbumURL        string
 	Label           string
+	LabelID         string
+	LabelURL        string
 	MediaURL        string
 	Duration        int
+	HasLyrics       bool
+	LyricsSnippet   string
 	PrimaryArtists  []Artist
 	FeaturedArtists []Artist
 }
 
+// LabelToken returns the token of the label of the song, to be passed to GetLabel.
+// LabelID identifies the label too, it matches the Label.ID returned by GetLabel
+func (s Song) LabelToken() string {
+	link, err := ParseURL(s.LabelURL)
+	if err != nil || link.Type != LinkLabel {
+		return ""
+	}
+
+	return link.Token
+}
+
 // Song API Response.
 type songAPIResponse struct {
 	ID              string `json:"id"`
@@ -111,6 +127,10 @@ type getSongAPIResponse struct {
 	Songs []songAPIResponse `json:"songs"`
 }
 
+func (res *getSongAPIResponse) count() int {
+	return len(res.Songs)
+}
+
 type songList []songAPIResponse
 
 func (s *songList) UnmarshalJSON(data []byte) error {
@@ -148,8 +168,12 @@ func (res *songAPIResponse) toSong() Song {
 		AlbumName:       res.MoreInfo.Album,
 		AlbumURL:        res.MoreInfo.AlbumURL,
 		Label:           res.MoreInfo.Label,
+		LabelID:         res.MoreInfo.LabelID,
+		LabelURL:        labelURL(res.MoreInfo.LabelURL),
 		MediaURL:        mediaURL,
 		Duration:        duration,
+		HasLyrics:       res.MoreInfo.HasLyrics == "true",
+		LyricsSnippet:   html.UnescapeString(res.MoreInfo.LyricsSnippet),
 	}
 
 	primaryArtists := make([]Artist, 0)
@@ -181,9 +205,18 @@ func (res *songAPIResponse) toSong() Song {
 	return song
 }
 
-func (res *getSongAPIResponse) toSong() (Song, error) {
+func (res *getSongAPIResponse) validate() error {
 	if len(res.Songs) == 0 {
-		return Song{}, fmt.Errorf("invalid song id")
+		return fmt.Errorf("invalid song id: %w", ErrNotFound)
+	}
+
+	return nil
+}
+
+func (res *getSongAPIResponse) toSong() (Song, error) {
+	err := res.validate()
+	if err != nil {
+		return Song{}, err
 	}
 
 	return res.Songs[0].toSong(), nil
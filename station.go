@@ -0,0 +1,213 @@
+package jiosaavn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Station Seed.
+type StationSeed struct {
+	endpoint string
+	params   map[string]string
+}
+
+// FeaturedStation seeds a featured station by name, e.g. "English Hits"
+func FeaturedStation(name, language string) StationSeed {
+	return StationSeed{
+		endpoint: createFeaturedStationEndpoint,
+		params: map[string]string{
+			"name":     strings.TrimSpace(name),
+			"language": strings.TrimSpace(language),
+		},
+	}
+}
+
+// ArtistStation seeds a station by artist name
+func ArtistStation(name, language string) StationSeed {
+	name = strings.TrimSpace(name)
+	return StationSeed{
+		endpoint: createArtistStationEndpoint,
+		params: map[string]string{
+			"name":     name,
+			"query":    name,
+			"language": strings.TrimSpace(language),
+		},
+	}
+}
+
+// SongStation seeds a station by song ids
+func SongStation(songIDs ...string) StationSeed {
+	ids := make([]string, 0)
+	for _, id := range songIDs {
+		id = strings.TrimSpace(id)
+		if len(id) > 0 {
+			ids = append(ids, id)
+		}
+	}
+
+	entityIDs, _ := json.Marshal(ids)
+	params := map[string]string{
+		"entity_type": "queue",
+	}
+	if len(ids) > 0 {
+		params["entity_id"] = string(entityIDs)
+	}
+
+	return StationSeed{
+		endpoint: createEntityStationEndpoint,
+		params:   params,
+	}
+}
+
+func (seed StationSeed) validate() error {
+	switch seed.endpoint {
+	case createFeaturedStationEndpoint, createArtistStationEndpoint:
+		if len(seed.params["name"]) == 0 {
+			return fmt.Errorf("station name cannot be empty: %w", ErrInvalidArgument)
+		}
+	case createEntityStationEndpoint:
+		if len(seed.params["entity_id"]) == 0 {
+			return fmt.Errorf("song ids cannot be empty: %w", ErrInvalidArgument)
+		}
+	default:
+		return fmt.Errorf("unknown station seed: %w", ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// Radio Station is a station which can be created with CreateStation,
+// e.g. the radio stations of the home page.
+type RadioStation struct {
+	Title    string
+	Subtitle string
+	Image    string
+	Language string
+	Seed     StationSeed
+}
+
+// Station is a radio station serving an endless stream of songs.
+// It is safe for concurrent use.
+type Station struct {
+	ID string
+
+	c      *Client
+	mu     sync.Mutex
+	played map[string]struct{}
+}
+
+// Create Station API Response.
+type createStationAPIResponse struct {
+	StationID string `json:"stationid"`
+}
+
+func (res *createStationAPIResponse) validate() error {
+	if len(res.StationID) == 0 {
+		return fmt.Errorf("station was not created: %w", ErrUnexpectedResponse)
+	}
+
+	return nil
+}
+
+func (res *createStationAPIResponse) count() int {
+	if len(res.StationID) == 0 {
+		return 0
+	}
+
+	return 1
+}
+
+// Station Songs API Response, songs are keyed on their index.
+type stationSongsAPIResponse struct {
+	StationID string
+	Songs     []songAPIResponse
+}
+
+func (res *stationSongsAPIResponse) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	type entry struct {
+		index int
+		song  songAPIResponse
+	}
+
+	entries := make([]entry, 0)
+	for key, value := range fields {
+		if key == "stationid" {
+			if err := json.Unmarshal(value, &res.StationID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+
+		var item struct {
+			Song songAPIResponse `json:"song"`
+		}
+		if err := json.Unmarshal(value, &item); err != nil {
+			return err
+		}
+		entries = append(entries, entry{index: index, song: item.Song})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].index < entries[j].index
+	})
+
+	res.Songs = make([]songAPIResponse, 0, len(entries))
+	for _, e := range entries {
+		res.Songs = append(res.Songs, e.song)
+	}
+
+	return nil
+}
+
+func (res *stationSongsAPIResponse) count() int {
+	return len(res.Songs)
+}
+
+// NextSongs returns up to k songs of the station which haven't been played yet
+func (s *Station) NextSongs(ctx context.Context, k int) ([]Song, error) {
+	if k < 1 || k > 20 {
+		return nil, s.c.reject(ctx, getStationSongsEndpoint, fmt.Errorf("k must be between 1 and 20: %w", ErrInvalidArgument))
+	}
+
+	params := make(map[string]string)
+	params["stationid"] = s.ID
+	params["k"] = strconv.Itoa(k)
+	params["next"] = "1"
+	params[callEndpoint] = getStationSongsEndpoint
+
+	apiResponse := new(stationSongsAPIResponse)
+	err := s.c.makeRequestAndUnmarshal(ctx, params, apiResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	songs := make([]Song, 0)
+	for _, result := range apiResponse.Songs {
+		if _, ok := s.played[result.ID]; ok || len(result.ID) == 0 {
+			continue
+		}
+
+		s.played[result.ID] = struct{}{}
+		songs = append(songs, result.toSong())
+	}
+
+	return songs, nil
+}
@@ -0,0 +1,158 @@
+// Package tracing instruments jiosaavn.Client with OpenTelemetry.
+// It is a separate module, so only programs importing it depend on OpenTelemetry.
+//
+// Middleware creates a span per client method call, marked as failed when the
+// call is rejected or finds nothing, and NewTransport a child span per HTTP
+// request, retries included:
+//
+//	c := jiosaavn.NewClient(
+//		&http.Client{Transport: tracing.NewTransport(nil)},
+//		jiosaavn.WithMiddleware(tracing.Middleware()),
+//	)
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ppalone/jiosaavn"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentation name
+const tracerName = "github.com/ppalone/jiosaavn/tracing"
+
+// attribute keys
+const (
+	operationKey   = attribute.Key("jiosaavn.operation")
+	endpointKey    = attribute.Key("jiosaavn.endpoint")
+	pageKey        = attribute.Key("jiosaavn.page")
+	limitKey       = attribute.Key("jiosaavn.limit")
+	resultCountKey = attribute.Key("jiosaavn.result_count")
+	attemptsKey    = attribute.Key("jiosaavn.attempts")
+	methodKey      = attribute.Key("http.request.method")
+	statusCodeKey  = attribute.Key("http.response.status_code")
+	urlKey         = attribute.Key("url.full")
+)
+
+// Option
+type Option func(cfg *config)
+
+type config struct {
+	tracerProvider trace.TracerProvider
+}
+
+// WithTracerProvider sets the tracer provider, defaults to the global one
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(cfg *config) {
+		cfg.tracerProvider = tp
+	}
+}
+
+func newTracer(opts []Option) trace.Tracer {
+	cfg := &config{
+		tracerProvider: otel.GetTracerProvider(),
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg.tracerProvider.Tracer(tracerName)
+}
+
+// Middleware returns a middleware creating a span per client method call
+func Middleware(opts ...Option) jiosaavn.Middleware {
+	tracer := newTracer(opts)
+
+	return func(next jiosaavn.Handler) jiosaavn.Handler {
+		return func(ctx context.Context, call *jiosaavn.Call) error {
+			name := call.Operation
+			if len(name) == 0 {
+				name = call.Endpoint
+			}
+
+			attrs := []attribute.KeyValue{
+				operationKey.String(call.Operation),
+				endpointKey.String(call.Endpoint),
+			}
+			if page, err := strconv.Atoi(call.Params["p"]); err == nil {
+				attrs = append(attrs, pageKey.Int(page))
+			}
+			if limit, err := strconv.Atoi(call.Params["n"]); err == nil {
+				attrs = append(attrs, limitKey.Int(limit))
+			}
+
+			ctx, span := tracer.Start(ctx, "jiosaavn."+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+			defer span.End()
+
+			err := next(ctx, call)
+
+			span.SetAttributes(
+				attemptsKey.Int(call.Attempts),
+				resultCountKey.Int(call.ResultCount),
+			)
+			if call.StatusCode > 0 {
+				span.SetAttributes(statusCodeKey.Int(call.StatusCode))
+			}
+
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		}
+	}
+}
+
+// Transport creates a span per HTTP request.
+type Transport struct {
+	base   http.RoundTripper
+	tracer trace.Tracer
+}
+
+// NewTransport wraps base, http.DefaultTransport when nil, to create a span per HTTP request
+func NewTransport(base http.RoundTripper, opts ...Option) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &Transport{
+		base:   base,
+		tracer: newTracer(opts),
+	}
+}
+
+// RoundTrip sends the request within a child span of the request context
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(
+		req.Context(),
+		"HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			methodKey.String(req.Method),
+			urlKey.String(req.URL.String()),
+			endpointKey.String(req.URL.Query().Get("__call")),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(statusCodeKey.Int(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+
+	return resp, nil
+}
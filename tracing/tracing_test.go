@@ -0,0 +1,162 @@
+package tracing_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ppalone/jiosaavn"
+	"github.com/ppalone/jiosaavn/jiosaavntest"
+	"github.com/ppalone/jiosaavn/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newClient(srv *jiosaavntest.Server, tp *sdktrace.TracerProvider, opts ...jiosaavn.ClientOption) *jiosaavn.Client {
+	opts = append([]jiosaavn.ClientOption{
+		jiosaavn.WithBaseURL(srv.URL),
+		jiosaavn.WithMiddleware(tracing.Middleware(tracing.WithTracerProvider(tp))),
+	}, opts...)
+
+	httpClient := &http.Client{Transport: tracing.NewTransport(nil, tracing.WithTracerProvider(tp))}
+	return jiosaavn.NewClient(httpClient, opts...)
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
+
+func TestTracing(t *testing.T) {
+	t.Run("with search and next", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		for i := 0; i < 15; i++ {
+			srv.AddSongs(jiosaavn.Song{ID: fmt.Sprintf("song%02d", i), Title: "Faded"})
+		}
+
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		c := newClient(srv, tp)
+
+		ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+		res, err := c.SearchSongs(ctx, "faded")
+		assert.NoError(t, err)
+		_, err = res.Next(ctx)
+		assert.NoError(t, err)
+		parent.End()
+
+		spans := exporter.GetSpans().Snapshots()
+		assert.Len(t, spans, 5)
+
+		calls := make([]sdktrace.ReadOnlySpan, 0)
+		for _, span := range spans {
+			if span.Name() == "jiosaavn.SearchSongs" {
+				calls = append(calls, span)
+			}
+		}
+		assert.Len(t, calls, 2)
+
+		for i, call := range calls {
+			assert.Equal(t, parent.SpanContext().SpanID(), call.Parent().SpanID())
+
+			attrs := attributes(call)
+			assert.Equal(t, "search.getResults", attrs["jiosaavn.endpoint"].AsString())
+			assert.Equal(t, int64(i+1), attrs["jiosaavn.page"].AsInt64())
+			assert.Equal(t, int64(10), attrs["jiosaavn.limit"].AsInt64())
+			assert.Equal(t, int64(1), attrs["jiosaavn.attempts"].AsInt64())
+		}
+		assert.Equal(t, int64(10), attributes(calls[0])["jiosaavn.result_count"].AsInt64())
+		assert.Equal(t, int64(5), attributes(calls[1])["jiosaavn.result_count"].AsInt64())
+
+		for _, span := range spans {
+			if span.Name() == "HTTP GET" {
+				assert.Equal(t, "jiosaavn.SearchSongs", findSpan(spans, span.Parent().SpanID()).Name())
+			}
+		}
+	})
+
+	t.Run("with retries and error", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+		srv.InjectFault("song.getDetails", jiosaavntest.Fault{StatusCode: http.StatusServiceUnavailable})
+
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		c := newClient(srv, tp, jiosaavn.WithRetryPolicy(jiosaavn.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+		}))
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.Error(t, err)
+
+		spans := exporter.GetSpans().Snapshots()
+		assert.Len(t, spans, 4)
+
+		call := spans[len(spans)-1]
+		assert.Equal(t, "jiosaavn.GetSongById", call.Name())
+		assert.Equal(t, codes.Error, call.Status().Code)
+		assert.Equal(t, int64(3), attributes(call)["jiosaavn.attempts"].AsInt64())
+		assert.Equal(t, int64(http.StatusServiceUnavailable), attributes(call)["http.response.status_code"].AsInt64())
+	})
+
+	t.Run("with unknown id", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		c := newClient(srv, tp)
+
+		_, err := c.GetSongById(context.Background(), "1xqHQw3J")
+		assert.ErrorIs(t, err, jiosaavn.ErrNotFound)
+
+		spans := exporter.GetSpans().Snapshots()
+		assert.Len(t, spans, 2)
+
+		call := spans[len(spans)-1]
+		assert.Equal(t, "jiosaavn.GetSongById", call.Name())
+		assert.Equal(t, codes.Error, call.Status().Code)
+		assert.Equal(t, int64(http.StatusOK), attributes(call)["http.response.status_code"].AsInt64())
+	})
+
+	t.Run("with invalid argument", func(t *testing.T) {
+		srv := jiosaavntest.NewServer()
+		defer srv.Close()
+
+		exporter := tracetest.NewInMemoryExporter()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		c := newClient(srv, tp)
+
+		_, err := c.SearchSongs(context.Background(), "faded", jiosaavn.WithLimit(50))
+		assert.ErrorIs(t, err, jiosaavn.ErrInvalidArgument)
+
+		spans := exporter.GetSpans().Snapshots()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "jiosaavn.SearchSongs", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, int64(0), attributes(spans[0])["jiosaavn.attempts"].AsInt64())
+		assert.Empty(t, srv.Requests())
+	})
+}
+
+func findSpan(spans []sdktrace.ReadOnlySpan, id trace.SpanID) sdktrace.ReadOnlySpan {
+	for _, span := range spans {
+		if span.SpanContext().SpanID() == id {
+			return span
+		}
+	}
+
+	return nil
+}
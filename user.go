@@ -0,0 +1,85 @@
+package jiosaavn
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// User, e.g. the owner of a playlist.
+type User struct {
+	ID        string
+	Username  string
+	FirstName string
+	LastName  string
+}
+
+// Name returns the full name of the user
+func (u User) Name() string {
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+// User playlists results.
+type UserPlaylistsResults struct {
+	Page      int
+	Size      int
+	Total     int
+	HasNext   bool
+	Playlists []Playlist
+
+	// for next
+	c             *Client
+	username      string
+	searchOptions *searchOptions
+}
+
+// User Playlists API Response.
+type userPlaylistsAPIResponse struct {
+	Total     int                   `json:"total"`
+	Playlists []playlistAPIResponse `json:"playlists"`
+}
+
+func (res *userPlaylistsAPIResponse) count() int {
+	return len(res.Playlists)
+}
+
+func (res *userPlaylistsAPIResponse) toResults(c *Client, username string, opts *searchOptions) (UserPlaylistsResults, error) {
+	playlists := make([]Playlist, 0)
+
+	for _, result := range res.Playlists {
+		playlists = append(playlists, result.toPlaylist())
+	}
+
+	offset := (opts.page - 1) * opts.limit
+	hasNext := len(playlists) > 0 && offset+len(playlists) < res.Total
+	if !hasNext {
+		return UserPlaylistsResults{
+			Page:      opts.page,
+			Size:      len(playlists),
+			Total:     res.Total,
+			HasNext:   hasNext,
+			Playlists: playlists,
+		}, nil
+	}
+
+	return UserPlaylistsResults{
+		Page:          opts.page,
+		Size:          len(playlists),
+		Total:         res.Total,
+		HasNext:       hasNext,
+		Playlists:     playlists,
+		c:             c,
+		username:      username,
+		searchOptions: opts,
+	}, nil
+}
+
+func (results *UserPlaylistsResults) Next(ctx context.Context) (UserPlaylistsResults, error) {
+	if !results.HasNext {
+		return UserPlaylistsResults{}, fmt.Errorf("doesn't have further results")
+	}
+
+	// next page is available
+	results.searchOptions.page += 1
+	return results.c.getUserPlaylists(ctx, results.username, results.searchOptions)
+}
@@ -27,10 +27,29 @@ func generateMediaURL(encryptedMediaURL string) (string, error) {
 		block.Decrypt(decrypted[start:start+blockSize], decodedBytes[start:start+blockSize])
 	}
 
-	return string(stripPKCS5Padding(decrypted)), nil
+	unpadded, err := stripPKCS5Padding(decrypted, blockSize)
+	if err != nil {
+		return "", err
+	}
+
+	return string(unpadded), nil
 }
 
-func stripPKCS5Padding(data []byte) []byte {
+func stripPKCS5Padding(data []byte, blockSize int) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("ciphertext is empty")
+	}
+
 	paddingLen := int(data[len(data)-1])
-	return data[:len(data)-paddingLen]
+	if paddingLen == 0 || paddingLen > blockSize || paddingLen > len(data) {
+		return nil, fmt.Errorf("invalid padding")
+	}
+
+	for _, b := range data[len(data)-paddingLen:] {
+		if int(b) != paddingLen {
+			return nil, fmt.Errorf("invalid padding")
+		}
+	}
+
+	return data[:len(data)-paddingLen], nil
 }